@@ -1,19 +1,50 @@
 package main
 
 import (
+	"context"
 	"log"
+	_ "time/tzdata" // Embedded timezone database for user timezones
 
 	"github.com/arulkarim/golden-architecture/configs"
 	_ "github.com/arulkarim/golden-architecture/docs" // Swagger docs
+	"github.com/arulkarim/golden-architecture/internal/attachment"
+	attachmenthandler "github.com/arulkarim/golden-architecture/internal/attachment/handler"
+	attachmentpostgres "github.com/arulkarim/golden-architecture/internal/attachment/postgres"
+	"github.com/arulkarim/golden-architecture/internal/comment"
+	commenthandler "github.com/arulkarim/golden-architecture/internal/comment/handler"
+	commentpostgres "github.com/arulkarim/golden-architecture/internal/comment/postgres"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
 	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
 	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
 	infrahttp "github.com/arulkarim/golden-architecture/internal/infrastructure/http"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/notifier"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/storage"
+	"github.com/arulkarim/golden-architecture/internal/notification"
+	notificationhandler "github.com/arulkarim/golden-architecture/internal/notification/handler"
+	notificationpostgres "github.com/arulkarim/golden-architecture/internal/notification/postgres"
+	"github.com/arulkarim/golden-architecture/internal/project"
+	projecthandler "github.com/arulkarim/golden-architecture/internal/project/handler"
+	projectpostgres "github.com/arulkarim/golden-architecture/internal/project/postgres"
+	"github.com/arulkarim/golden-architecture/internal/reminder"
+	reminderhandler "github.com/arulkarim/golden-architecture/internal/reminder/handler"
+	reminderpostgres "github.com/arulkarim/golden-architecture/internal/reminder/postgres"
+	"github.com/arulkarim/golden-architecture/internal/tag"
+	taghandler "github.com/arulkarim/golden-architecture/internal/tag/handler"
+	tagpostgres "github.com/arulkarim/golden-architecture/internal/tag/postgres"
+	"github.com/arulkarim/golden-architecture/internal/template"
+	templatehandler "github.com/arulkarim/golden-architecture/internal/template/handler"
+	templatepostgres "github.com/arulkarim/golden-architecture/internal/template/postgres"
+	"github.com/arulkarim/golden-architecture/internal/timetracking"
+	timetrackinghandler "github.com/arulkarim/golden-architecture/internal/timetracking/handler"
+	timetrackingpostgres "github.com/arulkarim/golden-architecture/internal/timetracking/postgres"
 	"github.com/arulkarim/golden-architecture/internal/todo"
 	todohandler "github.com/arulkarim/golden-architecture/internal/todo/handler"
 	todopostgres "github.com/arulkarim/golden-architecture/internal/todo/postgres"
 	"github.com/arulkarim/golden-architecture/internal/user"
 	userhandler "github.com/arulkarim/golden-architecture/internal/user/handler"
 	userpostgres "github.com/arulkarim/golden-architecture/internal/user/postgres"
+	"github.com/arulkarim/golden-architecture/pkg/cursor"
+	"github.com/arulkarim/golden-architecture/pkg/logger"
 	"github.com/arulkarim/golden-architecture/pkg/validator"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -44,14 +75,112 @@ func main() {
 	// Initialize JWT manager
 	jwtManager := auth.NewJWTManager(&cfg.JWT)
 
-	// Wire Todo dependencies
+	// Initialize transaction manager
+	transactor := database.NewTransactor(db)
+
+	// Wire Tag dependencies
+	tagRepo := tagpostgres.NewTagRepository(db)
+	tagService := tag.NewService(tagRepo)
+	tagHandler := taghandler.NewHandler(tagService)
+
+	// Wire Project dependencies; invitees are looked up among the users and
+	// the todos of a deleted project are moved to their owners' inboxes
+	userRepo := userpostgres.NewUserRepository(db)
 	todoRepo := todopostgres.NewTodoRepository(db)
-	todoService := todo.NewService(todoRepo)
-	todoHandler := todohandler.NewHandler(todoService)
+	projectRepo := projectpostgres.NewProjectRepository(db)
+	memberRepo := projectpostgres.NewProjectMemberRepository(db)
+	invitationRepo := projectpostgres.NewProjectInvitationRepository(db)
+	projectService := project.NewService(projectRepo, memberRepo, invitationRepo, userRepo, todoRepo, transactor)
+	projectHandler := projecthandler.NewHandler(projectService)
+
+	// Initialize attachment storage
+	blobStore, err := storage.New(&cfg.Attachment)
+	if err != nil {
+		log.Fatalf("Failed to initialize attachment storage: %v", err)
+	}
+
+	// Wire todo access; comments, attachments, time tracking and reminders
+	// reach todos only through it
+	todoAccess := todo.NewAccess(todoRepo, memberRepo)
+
+	// Wire Comment dependencies; mentions notify the users they name
+	commentRepo := commentpostgres.NewCommentRepository(db)
+	notificationRepo := notificationpostgres.NewNotificationRepository(db)
+	commentService := comment.NewService(todoAccess, commentRepo, notificationRepo, userRepo, transactor)
+	commentHandler := commenthandler.NewHandler(commentService)
+
+	// Wire Attachment dependencies
+	attachmentRepo := attachmentpostgres.NewAttachmentRepository(db)
+	attachmentPolicy := attachment.Policy{
+		MaxSize:      cfg.Attachment.MaxSize(),
+		AllowedTypes: cfg.Attachment.Types(),
+	}
+	attachmentService := attachment.NewService(todoAccess, attachmentRepo, blobStore, attachmentPolicy, transactor)
+	attachmentHandler := attachmenthandler.NewHandler(attachmentService)
+
+	// Wire Time tracking dependencies
+	timeEntryRepo := timetrackingpostgres.NewTimeEntryRepository(db)
+	timeTrackingService := timetracking.NewService(todoAccess, timeEntryRepo, transactor)
+	timeTrackingHandler := timetrackinghandler.NewHandler(timeTrackingService)
+
+	// Wire Reminder dependencies
+	reminderRepo := reminderpostgres.NewReminderRepository(db)
+	reminderService := reminder.NewService(todoAccess, reminderRepo, transactor)
+	reminderHandler := reminderhandler.NewHandler(reminderService)
+
+	// Wire Todo dependencies; purging todos removes their attachments and
+	// recurring todos pass their reminders on
+	todoService := todo.NewService(todo.Deps{
+		Todos:        todoRepo,
+		Tags:         tagRepo,
+		TagService:   tagService,
+		Checklist:    todopostgres.NewChecklistRepository(db),
+		Events:       todopostgres.NewTodoEventRepository(db),
+		Dependencies: todopostgres.NewTodoDependencyRepository(db),
+		Projects:     projectRepo,
+		Members:      memberRepo,
+		Access:       todoAccess,
+		Purge:        attachmentService,
+		Occurrences:  reminderService,
+		Transactor:   transactor,
+	})
+	cursorSecret, err := cfg.CursorSecret()
+	if err != nil {
+		log.Fatalf("Failed to derive cursor secret: %v", err)
+	}
+	todoHandler := todohandler.NewHandler(todoService, cursor.NewCodec(cursorSecret), cfg.Todo.RequireIfMatch)
+
+	// Wire Template dependencies; instantiating creates todos through the todo service
+	templateRepo := templatepostgres.NewTemplateRepository(db)
+	templateService := template.NewService(templateRepo, todoService)
+	templateHandler := templatehandler.NewHandler(templateService)
+
+	// Wire Notification dependencies; todos record them, users read them
+	notificationService := notification.NewService(notificationRepo)
+	notificationHandler := notificationhandler.NewHandler(notificationService)
+
+	// Initialize reminder delivery: the inbox always, email and webhooks when configured
+	externalNotifiers, err := notifier.New(&cfg.Notifier)
+	if err != nil {
+		log.Fatalf("Failed to initialize notifiers: %v", err)
+	}
+	notifiers := append([]contract.Notifier{notification.NewInbox(notificationRepo)}, externalNotifiers...)
+
+	// Start background jobs; they stop when the server shuts down
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	purger := todo.NewPurger(todoService, cfg.Trash.Retention(), cfg.Trash.PurgeInterval(), logger.New())
+	go purger.Run(ctx)
+	rebalancer := todo.NewRebalancer(todoService, cfg.Todo.PositionLimit(), cfg.Todo.RebalanceInterval(), logger.New())
+	go rebalancer.Run(ctx)
+	scheduler := notification.NewScheduler(
+		reminderRepo, transactor, notifiers,
+		cfg.Reminder.PollInterval(), cfg.Reminder.Batch(), cfg.Reminder.Attempts(), logger.New(),
+	)
+	go scheduler.Run(ctx)
 
 	// Wire User/Auth dependencies
-	userRepo := userpostgres.NewUserRepository(db)
-	userService := user.NewService(userRepo, jwtManager)
+	userService := user.NewService(userRepo, invitationRepo, transactor, jwtManager)
 	userHandler := userhandler.NewHandler(userService)
 
 	// Create HTTP server
@@ -59,8 +188,16 @@ func main() {
 
 	// Register routes
 	api := server.Engine().Group("/api/v1")
-	todohandler.RegisterRoutes(api, todoHandler)
+	todohandler.RegisterRoutes(api, todoHandler, jwtManager)
+	commenthandler.RegisterRoutes(api, commentHandler, jwtManager)
+	attachmenthandler.RegisterRoutes(api, attachmentHandler, jwtManager)
+	timetrackinghandler.RegisterRoutes(api, timeTrackingHandler, jwtManager)
+	reminderhandler.RegisterRoutes(api, reminderHandler, jwtManager)
+	taghandler.RegisterRoutes(api, tagHandler, jwtManager)
+	projecthandler.RegisterRoutes(api, projectHandler, jwtManager)
 	userhandler.RegisterRoutes(api, userHandler, jwtManager)
+	notificationhandler.RegisterRoutes(api, notificationHandler, jwtManager)
+	templatehandler.RegisterRoutes(api, templateHandler, jwtManager)
 
 	// Swagger documentation endpoint
 	server.Engine().GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
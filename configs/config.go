@@ -1,16 +1,261 @@
 package configs
 
 import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	JWT        JWTConfig
+	Pagination PaginationConfig
+	Trash      TrashConfig
+	Todo       TodoConfig
+	Attachment AttachmentConfig
+	Reminder   ReminderConfig
+	Notifier   NotifierConfig
+}
+
+// ReminderConfig holds reminder scheduling settings.
+// Due reminders are looked for every PollIntervalSeconds (30 when unset),
+// up to BatchSize at a time (100 when unset). A reminder whose delivery
+// keeps failing is given up on after MaxAttempts (5 when unset).
+type ReminderConfig struct {
+	PollIntervalSeconds int `mapstructure:"poll_interval_seconds"`
+	BatchSize           int `mapstructure:"batch_size"`
+	MaxAttempts         int `mapstructure:"max_attempts"`
+}
+
+// PollInterval returns how often due reminders are looked for
+func (r *ReminderConfig) PollInterval() time.Duration {
+	if r.PollIntervalSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(r.PollIntervalSeconds) * time.Second
+}
+
+// Batch returns how many due reminders are claimed at a time
+func (r *ReminderConfig) Batch() int {
+	if r.BatchSize <= 0 {
+		return 100
+	}
+	return r.BatchSize
+}
+
+// Attempts returns how many times a reminder's delivery is tried
+func (r *ReminderConfig) Attempts() int {
+	if r.MaxAttempts <= 0 {
+		return 5
+	}
+	return r.MaxAttempts
+}
+
+// NotifierConfig holds the channels notifications are delivered through
+// besides the in-app inbox. Email is sent when SMTP.Host is set and
+// webhooks are called when Webhook.URL is set.
+type NotifierConfig struct {
+	SMTP    SMTPConfig    `mapstructure:"smtp"`
+	Webhook WebhookConfig `mapstructure:"webhook"`
+}
+
+// SMTPConfig holds the settings of the mail server email is sent through.
+// STARTTLS is used when the server offers it; Username and Password are
+// only sent when set.
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+// Addr returns the host:port of the mail server, port 25 when unset
+func (s *SMTPConfig) Addr() string {
+	port := s.Port
+	if port <= 0 {
+		port = 25
+	}
+	return fmt.Sprintf("%s:%d", s.Host, port)
+}
+
+// WebhookConfig holds the settings of the webhook notifications are
+// posted to as JSON. Secret, when set, signs each body with HMAC-SHA256.
+// Requests time out after TimeoutSeconds (10 when unset).
+type WebhookConfig struct {
+	URL            string `mapstructure:"url"`
+	Secret         string `mapstructure:"secret"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds"`
+}
+
+// Timeout returns how long a webhook request may take
+func (w *WebhookConfig) Timeout() time.Duration {
+	if w.TimeoutSeconds <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(w.TimeoutSeconds) * time.Second
+}
+
+// AttachmentConfig holds attachment upload and storage settings.
+// Uploads are limited to MaxSizeMB (10 when unset) and to the AllowedTypes
+// sniffed from their content (DefaultAttachmentTypes when unset). Blobs are
+// kept below LocalDir (./data/attachments when unset) unless Storage is
+// "s3", which keeps them in the bucket configured by S3.
+type AttachmentConfig struct {
+	MaxSizeMB    int      `mapstructure:"max_size_mb"`
+	AllowedTypes []string `mapstructure:"allowed_types"`
+	Storage      string   `mapstructure:"storage"`
+	LocalDir     string   `mapstructure:"local_dir"`
+	S3           S3Config `mapstructure:"s3"`
+}
+
+// DefaultAttachmentTypes are the media types accepted for attachments when
+// none are configured. Office documents are sniffed as application/zip.
+var DefaultAttachmentTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"application/zip",
+	"text/plain",
+}
+
+// MaxSize returns the largest attachment size in bytes
+func (a *AttachmentConfig) MaxSize() int64 {
+	if a.MaxSizeMB <= 0 {
+		return 10 << 20
+	}
+	return int64(a.MaxSizeMB) << 20
+}
+
+// Types returns the media types accepted for attachments
+func (a *AttachmentConfig) Types() []string {
+	if len(a.AllowedTypes) == 0 {
+		return DefaultAttachmentTypes
+	}
+	return a.AllowedTypes
+}
+
+// Dir returns the directory local attachment storage writes to
+func (a *AttachmentConfig) Dir() string {
+	if a.LocalDir == "" {
+		return "./data/attachments"
+	}
+	return a.LocalDir
+}
+
+// S3Config holds the settings of an S3-compatible object store.
+// Endpoint is a URL such as https://s3.eu-west-1.amazonaws.com or
+// http://localhost:9000 for MinIO; PathStyle addresses the bucket in the
+// path instead of the host name, as MinIO requires. Requests, including
+// streaming their bodies, time out after TimeoutSeconds (60 when unset).
+type S3Config struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	PathStyle       bool   `mapstructure:"path_style"`
+	TimeoutSeconds  int    `mapstructure:"timeout_seconds"`
+}
+
+// Timeout returns how long an S3 request may take
+func (s *S3Config) Timeout() time.Duration {
+	if s.TimeoutSeconds <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(s.TimeoutSeconds) * time.Second
+}
+
+// RegionOrDefault returns the region requests are signed for
+func (s *S3Config) RegionOrDefault() string {
+	if s.Region == "" {
+		return "us-east-1"
+	}
+	return s.Region
+}
+
+// TodoConfig holds todo API settings.
+// RequireIfMatch rejects updates and deletes without an If-Match header
+// with 428 Precondition Required instead of applying them unconditionally.
+// Todo positions are rebalanced once one grows longer than
+// MaxPositionLength (32 when unset), checked every
+// RebalanceIntervalMinutes (10 when unset).
+type TodoConfig struct {
+	RequireIfMatch           bool `mapstructure:"require_if_match"`
+	MaxPositionLength        int  `mapstructure:"max_position_length"`
+	RebalanceIntervalMinutes int  `mapstructure:"rebalance_interval_minutes"`
+}
+
+// PositionLimit returns the position length that triggers a rebalance
+func (t *TodoConfig) PositionLimit() int {
+	if t.MaxPositionLength <= 0 {
+		return 32
+	}
+	return t.MaxPositionLength
+}
+
+// RebalanceInterval returns how often todo positions are checked
+func (t *TodoConfig) RebalanceInterval() time.Duration {
+	if t.RebalanceIntervalMinutes <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(t.RebalanceIntervalMinutes) * time.Minute
+}
+
+// TrashConfig holds trash retention settings.
+// Trashed todos are purged RetentionDays after deletion (30 when unset);
+// the purge runs every PurgeIntervalMinutes (60 when unset).
+type TrashConfig struct {
+	RetentionDays        int `mapstructure:"retention_days"`
+	PurgeIntervalMinutes int `mapstructure:"purge_interval_minutes"`
+}
+
+// Retention returns how long trashed todos are kept
+func (t *TrashConfig) Retention() time.Duration {
+	if t.RetentionDays <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return time.Duration(t.RetentionDays) * 24 * time.Hour
+}
+
+// PurgeInterval returns how often the trash is purged
+func (t *TrashConfig) PurgeInterval() time.Duration {
+	if t.PurgeIntervalMinutes <= 0 {
+		return time.Hour
+	}
+	return time.Duration(t.PurgeIntervalMinutes) * time.Minute
+}
+
+// PaginationConfig holds list pagination settings.
+// CursorSecret signs keyset cursors; see Config.CursorSecret when empty.
+type PaginationConfig struct {
+	CursorSecret string `mapstructure:"cursor_secret"`
+}
+
+// CursorSecret returns the secret signing keyset cursors. Without a
+// dedicated pagination.cursor_secret one is derived from the JWT secret
+// with HKDF under a "cursor" label, so the JWT secret itself never signs
+// cursors.
+func (c *Config) CursorSecret() (string, error) {
+	if c.Pagination.CursorSecret != "" {
+		return c.Pagination.CursorSecret, nil
+	}
+	if c.JWT.Secret == "" {
+		return "", errors.New("pagination.cursor_secret or jwt.secret is required")
+	}
+	key, err := hkdf.Key(sha256.New, []byte(c.JWT.Secret), nil, "cursor", sha256.Size)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
 }
 
 type JWTConfig struct {
@@ -0,0 +1,48 @@
+package configs
+
+import "testing"
+
+func TestCursorSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "dedicated secret",
+			config: Config{JWT: JWTConfig{Secret: "jwt"}, Pagination: PaginationConfig{CursorSecret: "cursor"}},
+			want:   "cursor",
+		},
+		{
+			name:   "derived from the JWT secret",
+			config: Config{JWT: JWTConfig{Secret: "jwt"}},
+		},
+		{
+			name:    "no secret",
+			config:  Config{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.CursorSecret()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CursorSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.want != "" && got != tt.want {
+				t.Errorf("CursorSecret() = %q, want %q", got, tt.want)
+			}
+			if got == "" || got == tt.config.JWT.Secret {
+				t.Errorf("CursorSecret() = %q, want a secret other than the JWT secret", got)
+			}
+			if again, _ := tt.config.CursorSecret(); again != got {
+				t.Errorf("CursorSecret() is not stable: %q then %q", got, again)
+			}
+		})
+	}
+}
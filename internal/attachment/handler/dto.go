@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// AttachmentResponse represents the response body for an attachment.
+// content_type is sniffed from the uploaded content.
+type AttachmentResponse struct {
+	ID          uint   `json:"id"`
+	TodoID      uint   `json:"todo_id"`
+	UploaderID  uint   `json:"uploader_id"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// NewAttachmentResponse maps an attachment entity to its response body
+func NewAttachmentResponse(a *entity.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          a.ID,
+		TodoID:      a.TodoID,
+		UploaderID:  a.UserID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		CreatedAt:   FormatTime(a.CreatedAt),
+	}
+}
+
+// NewAttachmentResponses maps attachment entities to their response bodies
+func NewAttachmentResponses(attachments []entity.Attachment) []AttachmentResponse {
+	responses := make([]AttachmentResponse, 0, len(attachments))
+	for i := range attachments {
+		responses = append(responses, NewAttachmentResponse(&attachments[i]))
+	}
+	return responses
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// FormatOptionalTime formats an optional time to RFC3339, keeping nil as nil
+func FormatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := FormatTime(*t)
+	return &formatted
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/arulkarim/golden-architecture/internal/attachment"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead is the room left for multipart headers and boundaries
+// on top of the largest attachment
+const multipartOverhead = 1 << 20
+
+// Handler handles HTTP requests for files attached to todos
+type Handler struct {
+	service *attachment.Service
+}
+
+// NewHandler creates a new attachment handler
+func NewHandler(service *attachment.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// List handles GET /api/v1/todos/:id/attachments
+func (h *Handler) List(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	attachments, err := h.service.List(c.Request.Context(), userID, todoID)
+	if err != nil {
+		if attachment.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to get attachments", err.Error())
+		return
+	}
+
+	response.OK(c, "Attachments retrieved successfully", NewAttachmentResponses(attachments))
+}
+
+// Upload handles POST /api/v1/todos/:id/attachments
+func (h *Handler) Upload(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	maxSize := h.service.MaxSize()
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+multipartOverhead)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.Error(c, http.StatusRequestEntityTooLarge, "Attachment too large", err.Error())
+			return
+		}
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+	defer file.Close()
+
+	uploaded, err := h.service.Add(c.Request.Context(), userID, todoID, attachment.AddInput{
+		Filename: header.Filename,
+		Size:     header.Size,
+		Content:  file,
+	})
+	if err != nil {
+		if attachment.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if attachment.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if attachment.IsTooLarge(err) {
+			response.Error(c, http.StatusRequestEntityTooLarge, "Attachment too large", err.Error())
+			return
+		}
+		if attachment.IsUnsupportedMediaType(err) {
+			response.UnsupportedMediaType(c, "Unsupported attachment type", err.Error())
+			return
+		}
+		if attachment.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to upload attachment", err.Error())
+		return
+	}
+
+	response.Created(c, "Attachment uploaded successfully", NewAttachmentResponse(uploaded))
+}
+
+// Download handles GET /api/v1/todos/:id/attachments/:attachmentId.
+// The content is streamed with its sniffed media type and honours Range
+// requests.
+func (h *Handler) Download(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	attachmentID, ok := param.ID(c, "attachmentId", "attachment")
+	if !ok {
+		return
+	}
+
+	meta, content, err := h.service.Open(c.Request.Context(), userID, todoID, attachmentID)
+	if err != nil {
+		if attachment.IsNotFound(err) {
+			response.NotFound(c, "Attachment not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to download attachment", err.Error())
+		return
+	}
+	defer content.Close()
+
+	// Attachments are always downloaded, never rendered by the browser
+	c.Header("Content-Type", meta.ContentType)
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": meta.Filename}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	http.ServeContent(c.Writer, c.Request, meta.Filename, meta.CreatedAt, content)
+}
+
+// Delete handles DELETE /api/v1/todos/:id/attachments/:attachmentId
+func (h *Handler) Delete(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	attachmentID, ok := param.ID(c, "attachmentId", "attachment")
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, todoID, attachmentID); err != nil {
+		if attachment.IsNotFound(err) {
+			response.NotFound(c, "Attachment not found")
+			return
+		}
+		if attachment.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		response.InternalServerError(c, "Failed to delete attachment", err.Error())
+		return
+	}
+
+	response.OK(c, "Attachment deleted successfully", nil)
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers attachment routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// Attachments live under the todo they are on, which must be visible to the user
+	todos := router.Group("/todos", auth.AuthMiddleware(jwtManager))
+	{
+		todos.GET("/:id/attachments", handler.List)
+		todos.POST("/:id/attachments", handler.Upload)
+		todos.GET("/:id/attachments/:attachmentId", handler.Download)
+		todos.DELETE("/:id/attachments/:attachmentId", handler.Delete)
+	}
+}
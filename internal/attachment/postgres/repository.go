@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// attachmentRepository implements contract.AttachmentRepository
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewAttachmentRepository creates a new AttachmentRepository instance
+func NewAttachmentRepository(db *gorm.DB) contract.AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+// Create creates a new attachment
+func (r *attachmentRepository) Create(ctx context.Context, attachment *entity.Attachment) error {
+	result := database.Conn(ctx, r.db).Create(attachment)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds an attachment of a todo by its ID
+func (r *attachmentRepository) FindByID(ctx context.Context, todoID, id uint) (*entity.Attachment, error) {
+	var attachment entity.Attachment
+	result := database.Conn(ctx, r.db).Where("todo_id = ?", todoID).First(&attachment, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &attachment, nil
+}
+
+// FindByTodo retrieves all attachments of a todo, oldest first
+func (r *attachmentRepository) FindByTodo(ctx context.Context, todoID uint) ([]entity.Attachment, error) {
+	var attachments []entity.Attachment
+	result := database.Conn(ctx, r.db).
+		Where("todo_id = ?", todoID).
+		Order("id ASC").
+		Find(&attachments)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return attachments, nil
+}
+
+// Delete deletes an attachment of a todo by its ID and returns it
+func (r *attachmentRepository) Delete(ctx context.Context, todoID, id uint) (*entity.Attachment, error) {
+	var attachments []entity.Attachment
+	result := database.Conn(ctx, r.db).
+		Clauses(clause.Returning{}).
+		Where("todo_id = ? AND id = ?", todoID, id).
+		Delete(&attachments)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	if len(attachments) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return &attachments[0], nil
+}
+
+// DeleteByTodos deletes every attachment of the given todos and returns
+// their storage keys
+func (r *attachmentRepository) DeleteByTodos(ctx context.Context, todoIDs []uint) ([]string, error) {
+	if len(todoIDs) == 0 {
+		return nil, nil
+	}
+
+	var attachments []entity.Attachment
+	result := database.Conn(ctx, r.db).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "storage_key"}}}).
+		Where("todo_id IN ?", todoIDs).
+		Delete(&attachments)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+
+	keys := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		keys = append(keys, attachment.StorageKey)
+	}
+	return keys, nil
+}
@@ -0,0 +1,279 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+const (
+	// sniffLength is the number of leading bytes the media type of an
+	// upload is detected from
+	sniffLength = 512
+	// maxFilenameLength is the longest attachment filename in characters
+	maxFilenameLength = 255
+)
+
+var (
+	// ErrTooLarge is returned when an upload exceeds the maximum
+	// attachment size
+	ErrTooLarge = errors.New("the attachment is too large")
+
+	// ErrUnsupportedMediaType is returned when the content of an upload is
+	// of a media type attachments may not have
+	ErrUnsupportedMediaType = errors.New("the attachment's media type is not allowed")
+)
+
+// Policy limits attachment uploads. AllowedTypes are media types without
+// parameters, matched against the type sniffed from the content.
+type Policy struct {
+	MaxSize      int64
+	AllowedTypes []string
+}
+
+// Service provides the business logic for files attached to todos
+type Service struct {
+	todos       contract.TodoAccess
+	attachments contract.AttachmentRepository
+	blobs       contract.BlobStore
+	policy      Policy
+	tx          contract.Transactor
+}
+
+// NewService creates a new attachment service
+func NewService(
+	todos contract.TodoAccess,
+	attachments contract.AttachmentRepository,
+	blobs contract.BlobStore,
+	policy Policy,
+	tx contract.Transactor,
+) *Service {
+	return &Service{
+		todos:       todos,
+		attachments: attachments,
+		blobs:       blobs,
+		policy:      policy,
+		tx:          tx,
+	}
+}
+
+// AddInput represents input for attaching a file to a todo.
+// Content must hold exactly Size bytes; the client's idea of its media
+// type is ignored.
+type AddInput struct {
+	Filename string
+	Size     int64
+	Content  io.Reader
+}
+
+// MaxSize returns the largest attachment size in bytes
+func (s *Service) MaxSize() int64 {
+	return s.policy.MaxSize
+}
+
+// List retrieves the attachments of a todo visible to the user, oldest
+// first
+func (s *Service) List(ctx context.Context, userID, todoID uint) ([]entity.Attachment, error) {
+	if userID == 0 || todoID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	return s.attachments.FindByTodo(ctx, todoID)
+}
+
+// Add stores a file and attaches it to one of the user's todos. The media
+// type is sniffed from the content and must be allowed by the policy.
+func (s *Service) Add(ctx context.Context, userID, todoID uint, input AddInput) (*entity.Attachment, error) {
+	filename := attachmentName(input.Filename)
+	if userID == 0 || todoID == 0 || filename == "" || input.Size <= 0 || input.Content == nil {
+		return nil, domain.ErrInvalidInput
+	}
+	if input.Size > s.policy.MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	if _, err := s.todos.FindWritable(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(input.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, domain.ErrInvalidInput
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !slices.Contains(s.policy.AllowedTypes, mediaType) {
+		return nil, ErrUnsupportedMediaType
+	}
+
+	key, err := storageKey(todoID)
+	if err != nil {
+		return nil, err
+	}
+	content := io.MultiReader(bytes.NewReader(head), input.Content)
+	if err := s.blobs.Put(ctx, key, content, input.Size, contentType); err != nil {
+		return nil, err
+	}
+
+	attachment := &entity.Attachment{
+		TodoID:      todoID,
+		UserID:      userID,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        input.Size,
+		StorageKey:  key,
+	}
+	if err := s.attachments.Create(ctx, attachment); err != nil {
+		// The todo may have been purged meanwhile; don't leave the blob behind
+		_ = s.blobs.Delete(ctx, key)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// Open finds an attachment of a todo visible to the user and opens its
+// content. The caller must close the content.
+func (s *Service) Open(ctx context.Context, userID, todoID, attachmentID uint) (*entity.Attachment, io.ReadSeekCloser, error) {
+	if userID == 0 || todoID == 0 || attachmentID == 0 {
+		return nil, nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.attachments.FindByID(ctx, todoID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
+
+// Delete removes an attachment and its content from one of the user's
+// todos
+func (s *Service) Delete(ctx context.Context, userID, todoID, attachmentID uint) error {
+	if userID == 0 || todoID == 0 || attachmentID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.todos.FindWritable(ctx, userID, todoID); err != nil {
+			return err
+		}
+
+		attachment, err := s.attachments.Delete(ctx, todoID, attachmentID)
+		if err != nil {
+			return err
+		}
+		return s.blobs.Delete(ctx, attachment.StorageKey)
+	})
+}
+
+// PurgeTodos deletes the attachments of todos about to be deleted for good.
+// It runs in the transaction deleting the todos; the returned func removes
+// the attachments' content and runs last in it, so a storage failure keeps
+// the todos and their attachments for a later attempt.
+func (s *Service) PurgeTodos(ctx context.Context, todoIDs []uint) (func(ctx context.Context) error, error) {
+	keys, err := s.attachments.DeleteByTodos(ctx, todoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) error {
+		return s.removeBlobs(ctx, keys)
+	}, nil
+}
+
+// removeBlobs deletes the blobs stored under the given keys
+func (s *Service) removeBlobs(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storageKey generates a random, unguessable key for a new blob of a todo
+func storageKey(todoID uint) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", domain.ErrStorageOperation
+	}
+	return fmt.Sprintf("todos/%d/%s", todoID, hex.EncodeToString(b)), nil
+}
+
+// attachmentName reduces a client-supplied filename to its base name
+// without control characters, at most maxFilenameLength characters long
+func attachmentName(name string) string {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == unicode.ReplacementChar {
+			return -1
+		}
+		return r
+	}, name))
+	if name == "." || name == ".." {
+		return ""
+	}
+	if runes := []rune(name); len(runes) > maxFilenameLength {
+		name = string(runes[:maxFilenameLength])
+	}
+	return name
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
+
+// IsForbidden checks if error is caused by a project role lacking the
+// permission to change a todo
+func IsForbidden(err error) bool {
+	return errors.Is(err, domain.ErrForbidden)
+}
+
+// IsTooLarge checks if error is caused by an upload exceeding the maximum
+// attachment size
+func IsTooLarge(err error) bool {
+	return errors.Is(err, ErrTooLarge)
+}
+
+// IsUnsupportedMediaType checks if error is caused by an upload of a media
+// type attachments may not have
+func IsUnsupportedMediaType(err error) bool {
+	return errors.Is(err, ErrUnsupportedMediaType)
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// ListCommentsRequest represents the query parameters for listing a todo's comments
+type ListCommentsRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// CreateCommentRequest represents the request body for commenting on a todo.
+// body is markdown; @email mentions notify the users they name.
+type CreateCommentRequest struct {
+	Body string `json:"body" binding:"required,min=1,max=10000"`
+}
+
+// UpdateCommentRequest represents the request body for editing a comment
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,min=1,max=10000"`
+}
+
+// CommentResponse represents the response body for a comment. edited_at is
+// set once the author has changed the body.
+type CommentResponse struct {
+	ID          uint    `json:"id"`
+	TodoID      uint    `json:"todo_id"`
+	AuthorID    uint    `json:"author_id"`
+	AuthorEmail string  `json:"author_email"`
+	Body        string  `json:"body"`
+	EditedAt    *string `json:"edited_at"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+// CommentListResponse represents the response body for a todo's comments
+type CommentListResponse struct {
+	Comments   []CommentResponse `json:"comments"`
+	Total      int64             `json:"total"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+	TotalPages int               `json:"total_pages"`
+}
+
+// NewCommentResponse maps a comment entity to its response body
+func NewCommentResponse(c *entity.Comment) CommentResponse {
+	return CommentResponse{
+		ID:          c.ID,
+		TodoID:      c.TodoID,
+		AuthorID:    c.UserID,
+		AuthorEmail: c.AuthorEmail,
+		Body:        c.Body,
+		EditedAt:    FormatOptionalTime(c.EditedAt),
+		CreatedAt:   FormatTime(c.CreatedAt),
+	}
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// FormatOptionalTime formats an optional time to RFC3339, keeping nil as nil
+func FormatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := FormatTime(*t)
+	return &formatted
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/comment"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for comments on todos
+type Handler struct {
+	service *comment.Service
+}
+
+// NewHandler creates a new comment handler
+func NewHandler(service *comment.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// List handles GET /api/v1/todos/:id/comments
+func (h *Handler) List(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req ListCommentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	result, err := h.service.List(c.Request.Context(), userID, todoID, comment.ListInput{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		if comment.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if comment.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get comments", err.Error())
+		return
+	}
+
+	comments := make([]CommentResponse, 0, len(result.Comments))
+	for i := range result.Comments {
+		comments = append(comments, NewCommentResponse(&result.Comments[i]))
+	}
+
+	resp := CommentListResponse{
+		Comments:   comments,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages(),
+	}
+
+	response.OK(c, "Comments retrieved successfully", resp)
+}
+
+// Create handles POST /api/v1/todos/:id/comments
+func (h *Handler) Create(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	created, err := h.service.Add(c.Request.Context(), userID, todoID, comment.AddInput{Body: req.Body})
+	if err != nil {
+		if comment.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if comment.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to create comment", err.Error())
+		return
+	}
+
+	response.Created(c, "Comment created successfully", NewCommentResponse(created))
+}
+
+// Update handles PUT /api/v1/todos/:id/comments/:commentId
+func (h *Handler) Update(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	commentID, ok := param.ID(c, "commentId", "comment")
+	if !ok {
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	updated, err := h.service.Update(c.Request.Context(), userID, todoID, commentID, comment.UpdateInput{Body: req.Body})
+	if err != nil {
+		if comment.IsNotFound(err) {
+			response.NotFound(c, "Comment not found")
+			return
+		}
+		if comment.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only the author can edit a comment")
+			return
+		}
+		if comment.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to update comment", err.Error())
+		return
+	}
+
+	response.OK(c, "Comment updated successfully", NewCommentResponse(updated))
+}
+
+// Delete handles DELETE /api/v1/todos/:id/comments/:commentId
+func (h *Handler) Delete(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	commentID, ok := param.ID(c, "commentId", "comment")
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, todoID, commentID); err != nil {
+		if comment.IsNotFound(err) {
+			response.NotFound(c, "Comment not found")
+			return
+		}
+		if comment.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only the author can delete a comment")
+			return
+		}
+		response.InternalServerError(c, "Failed to delete comment", err.Error())
+		return
+	}
+
+	response.OK(c, "Comment deleted successfully", nil)
+}
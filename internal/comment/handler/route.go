@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers comment routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// Comments live under the todo they are on, which must be visible to the user
+	todos := router.Group("/todos", auth.AuthMiddleware(jwtManager))
+	{
+		todos.GET("/:id/comments", handler.List)
+		todos.POST("/:id/comments", handler.Create)
+		todos.PUT("/:id/comments/:commentId", handler.Update)
+		todos.DELETE("/:id/comments/:commentId", handler.Delete)
+	}
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// commentRepository implements contract.CommentRepository
+type commentRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentRepository creates a new CommentRepository instance
+func NewCommentRepository(db *gorm.DB) contract.CommentRepository {
+	return &commentRepository{db: db}
+}
+
+// withAuthor selects comments together with their author's email
+func withAuthor(db *gorm.DB) *gorm.DB {
+	return db.
+		Select("comments.*, users.email AS author_email").
+		Joins("JOIN users ON users.id = comments.user_id")
+}
+
+// Create creates a new comment
+func (r *commentRepository) Create(ctx context.Context, comment *entity.Comment) error {
+	result := database.Conn(ctx, r.db).Create(comment)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds a comment of a todo by its ID
+func (r *commentRepository) FindByID(ctx context.Context, todoID, id uint) (*entity.Comment, error) {
+	var comment entity.Comment
+	result := database.Conn(ctx, r.db).
+		Scopes(withAuthor).
+		Where("comments.todo_id = ?", todoID).
+		First(&comment, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &comment, nil
+}
+
+// FindByTodo retrieves one page of a todo's comments, oldest first
+func (r *commentRepository) FindByTodo(ctx context.Context, todoID uint, limit, offset int) ([]entity.Comment, int64, error) {
+	var total int64
+	result := database.Conn(ctx, r.db).
+		Model(&entity.Comment{}).
+		Where("todo_id = ?", todoID).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	var comments []entity.Comment
+	result = database.Conn(ctx, r.db).
+		Scopes(withAuthor).
+		Where("comments.todo_id = ?", todoID).
+		Order("comments.id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&comments)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+	return comments, total, nil
+}
+
+// Update updates the body and edit time of an existing comment
+func (r *commentRepository) Update(ctx context.Context, comment *entity.Comment) error {
+	result := database.Conn(ctx, r.db).
+		Model(comment).
+		Where("todo_id = ?", comment.TodoID).
+		Select("body", "edited_at", "updated_at").
+		Updates(comment)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete soft-deletes a comment of a todo by its ID
+func (r *commentRepository) Delete(ctx context.Context, todoID, id uint) error {
+	result := database.Conn(ctx, r.db).Where("todo_id = ?", todoID).Delete(&entity.Comment{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
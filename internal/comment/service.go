@@ -0,0 +1,292 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/pkg/mention"
+)
+
+const (
+	// DefaultPageSize is the page size used when none is requested
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page size a caller may request
+	MaxPageSize = 100
+	// MaxLength is the longest comment body in characters
+	MaxLength = 10000
+)
+
+// Service provides the business logic for comments on todos
+type Service struct {
+	todos         contract.TodoAccess
+	comments      contract.CommentRepository
+	notifications contract.NotificationRepository
+	users         contract.UserRepository
+	tx            contract.Transactor
+}
+
+// NewService creates a new comment service
+func NewService(
+	todos contract.TodoAccess,
+	comments contract.CommentRepository,
+	notifications contract.NotificationRepository,
+	users contract.UserRepository,
+	tx contract.Transactor,
+) *Service {
+	return &Service{
+		todos:         todos,
+		comments:      comments,
+		notifications: notifications,
+		users:         users,
+		tx:            tx,
+	}
+}
+
+// ListInput represents input for listing the comments of a todo
+type ListInput struct {
+	Page     int
+	PageSize int
+}
+
+// AddInput represents input for commenting on a todo. Body is markdown;
+// @email mentions notify the users they name.
+type AddInput struct {
+	Body string
+}
+
+// UpdateInput represents input for editing a comment
+type UpdateInput struct {
+	Body string
+}
+
+// Page represents one page of a todo's comments
+type Page struct {
+	Comments []entity.Comment
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+// TotalPages returns the number of pages available for the comments
+func (p *Page) TotalPages() int {
+	if p.PageSize == 0 {
+		return 0
+	}
+	return int((p.Total + int64(p.PageSize) - 1) / int64(p.PageSize))
+}
+
+// List retrieves one page of the comments of a todo visible to the user,
+// oldest first
+func (s *Service) List(ctx context.Context, userID, todoID uint, input ListInput) (*Page, error) {
+	if userID == 0 || todoID == 0 || input.Page < 0 || input.PageSize < 0 || input.PageSize > MaxPageSize {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	page := input.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	comments, total, err := s.comments.FindByTodo(ctx, todoID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Page{
+		Comments: comments,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// Add adds a comment to a todo visible to the user. Viewers may comment
+// too. Users mentioned in the body who can see the todo are notified.
+func (s *Service) Add(ctx context.Context, userID, todoID uint, input AddInput) (*entity.Comment, error) {
+	body, ok := commentBody(input.Body)
+	if userID == 0 || todoID == 0 || !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var comment *entity.Comment
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		todo, err := s.todos.FindVisible(ctx, userID, todoID)
+		if err != nil {
+			return err
+		}
+
+		created := &entity.Comment{
+			TodoID: todoID,
+			UserID: userID,
+			Body:   body,
+		}
+		if err := s.comments.Create(ctx, created); err != nil {
+			return err
+		}
+		if err := s.notifyMentions(ctx, todo, created, nil); err != nil {
+			return err
+		}
+
+		comment, err = s.comments.FindByID(ctx, todoID, created.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// Update changes the body of a comment on a todo visible to the user. Only
+// the author may edit a comment. Users mentioned for the first time are
+// notified.
+func (s *Service) Update(ctx context.Context, userID, todoID, commentID uint, input UpdateInput) (*entity.Comment, error) {
+	body, ok := commentBody(input.Body)
+	if userID == 0 || todoID == 0 || commentID == 0 || !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var comment *entity.Comment
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		todo, err := s.todos.FindVisible(ctx, userID, todoID)
+		if err != nil {
+			return err
+		}
+
+		comment, err = s.findOwn(ctx, userID, todoID, commentID)
+		if err != nil {
+			return err
+		}
+		if comment.Body == body {
+			return nil
+		}
+
+		previous := mention.Emails(comment.Body)
+		now := time.Now()
+		comment.Body = body
+		comment.EditedAt = &now
+		if err := s.comments.Update(ctx, comment); err != nil {
+			return err
+		}
+		return s.notifyMentions(ctx, todo, comment, previous)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// Delete deletes a comment on a todo visible to the user. Only the author
+// may delete a comment.
+func (s *Service) Delete(ctx context.Context, userID, todoID, commentID uint) error {
+	if userID == 0 || todoID == 0 || commentID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+			return err
+		}
+		if _, err := s.findOwn(ctx, userID, todoID, commentID); err != nil {
+			return err
+		}
+		return s.comments.Delete(ctx, todoID, commentID)
+	})
+}
+
+// findOwn finds a comment of a todo and checks that the user wrote it
+func (s *Service) findOwn(ctx context.Context, userID, todoID, commentID uint) (*entity.Comment, error) {
+	comment, err := s.comments.FindByID(ctx, todoID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != userID {
+		return nil, domain.ErrForbidden
+	}
+	return comment, nil
+}
+
+// notifyMentions notifies the users mentioned in a comment, except those
+// already mentioned in its previous body, the author and users who cannot
+// see the todo. Addresses are matched against users ignoring case, like
+// logins and invitations; unknown addresses are ignored.
+func (s *Service) notifyMentions(ctx context.Context, todo *entity.Todo, comment *entity.Comment, previous []string) error {
+	skip := make(map[string]bool, len(previous))
+	for _, email := range previous {
+		skip[strings.ToLower(email)] = true
+	}
+
+	var notifications []entity.Notification
+	notified := make(map[uint]bool)
+	for _, email := range mention.Emails(comment.Body) {
+		if skip[strings.ToLower(email)] {
+			continue
+		}
+
+		user, err := s.users.FindByEmail(ctx, email)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if user.ID == comment.UserID || notified[user.ID] {
+			continue
+		}
+
+		_, err = s.todos.FindVisible(ctx, user.ID, todo.ID)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		notified[user.ID] = true
+		notifications = append(notifications, entity.Notification{
+			UserID:    user.ID,
+			ActorID:   &comment.UserID,
+			Type:      entity.NotificationMention,
+			TodoID:    todo.ID,
+			CommentID: &comment.ID,
+		})
+	}
+	return s.notifications.CreateBatch(ctx, notifications)
+}
+
+// commentBody trims a comment body and checks that it is neither empty nor
+// longer than MaxLength
+func commentBody(body string) (string, bool) {
+	body = strings.TrimSpace(body)
+	return body, body != "" && utf8.RuneCountInString(body) <= MaxLength
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
+
+// IsForbidden checks if error is caused by changing another user's comment
+func IsForbidden(err error) bool {
+	return errors.Is(err, domain.ErrForbidden)
+}
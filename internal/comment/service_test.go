@@ -0,0 +1,116 @@
+package comment
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// fakeUsers finds users by email ignoring case, as contract.UserRepository
+// requires
+type fakeUsers struct {
+	contract.UserRepository
+	byEmail map[string]entity.User
+}
+
+func (f *fakeUsers) FindByEmail(_ context.Context, email string) (*entity.User, error) {
+	user, ok := f.byEmail[strings.ToLower(email)]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &user, nil
+}
+
+// fakeAccess lets the listed users see every todo
+type fakeAccess struct {
+	contract.TodoAccess
+	visible map[uint]bool
+}
+
+func (f *fakeAccess) FindVisible(_ context.Context, userID, todoID uint) (*entity.Todo, error) {
+	if !f.visible[userID] {
+		return nil, domain.ErrNotFound
+	}
+	return &entity.Todo{ID: todoID}, nil
+}
+
+// fakeNotifications records the notifications created
+type fakeNotifications struct {
+	contract.NotificationRepository
+	created []entity.Notification
+}
+
+func (f *fakeNotifications) CreateBatch(_ context.Context, notifications []entity.Notification) error {
+	f.created = append(f.created, notifications...)
+	return nil
+}
+
+func TestNotifyMentions(t *testing.T) {
+	users := &fakeUsers{byEmail: map[string]entity.User{
+		"author@example.com": {ID: 1, Email: "author@example.com"},
+		"jane@example.com":   {ID: 2, Email: "Jane@Example.com"},
+		"bob@example.com":    {ID: 3, Email: "bob@example.com"},
+		"eve@example.com":    {ID: 4, Email: "eve@example.com"},
+	}}
+	access := &fakeAccess{visible: map[uint]bool{1: true, 2: true, 3: true}}
+
+	tests := []struct {
+		name     string
+		body     string
+		previous []string
+		want     []uint
+	}{
+		{
+			name: "address matched ignoring case",
+			body: "ping @jane@example.com and @BOB@EXAMPLE.COM",
+			want: []uint{2, 3},
+		},
+		{
+			name:     "previously mentioned in another case",
+			body:     "ping @jane@example.com and @bob@example.com",
+			previous: []string{"JANE@example.com"},
+			want:     []uint{3},
+		},
+		{
+			name: "author is not notified",
+			body: "note to self @Author@example.com",
+		},
+		{
+			name: "user who cannot see the todo is not notified",
+			body: "ping @eve@example.com",
+		},
+		{
+			name: "unknown address is ignored",
+			body: "ping @nobody@example.com and @jane@example.com",
+			want: []uint{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications := &fakeNotifications{}
+			s := NewService(access, nil, notifications, users, nil)
+			comment := &entity.Comment{ID: 7, TodoID: 5, UserID: 1, Body: tt.body}
+
+			if err := s.notifyMentions(context.Background(), &entity.Todo{ID: 5}, comment, tt.previous); err != nil {
+				t.Fatalf("notifyMentions() unexpected error: %v", err)
+			}
+
+			var got []uint
+			for _, n := range notifications.created {
+				if n.Type != entity.NotificationMention || n.TodoID != 5 || n.CommentID == nil || *n.CommentID != 7 {
+					t.Errorf("notification %+v does not point at the comment", n)
+				}
+				got = append(got, n.UserID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("notified users = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package contract
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore stores file contents under opaque, slash-separated keys.
+// Missing blobs are reported as domain.ErrNotFound and other failures as
+// domain.ErrStorageOperation.
+type BlobStore interface {
+	// Put stores size bytes read from content under key, replacing any
+	// blob already stored there
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+
+	// Open opens the blob stored under key for reading. The returned
+	// reader can seek, so parts of the blob can be served on their own.
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+
+	// Delete removes the blob stored under key. Deleting a missing blob
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,29 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// Notifier delivers notifications to users through one channel, such as
+// their inbox, email or a webhook. Callers retry failed deliveries, so
+// implementations report failures instead of retrying on their own.
+type Notifier interface {
+	// Channel names the channel, which identifies the notifier among the
+	// channels a notification has already been delivered to
+	Channel() string
+
+	// Notify delivers one message
+	Notify(ctx context.Context, message Message) error
+}
+
+// Message is one notification to deliver. Notification is what the inbox
+// keeps; Email is the recipient's address and Subject and Body the text
+// sent to channels outside the application.
+type Message struct {
+	Notification entity.Notification
+	Email        string
+	Subject      string
+	Body         string
+}
@@ -2,26 +2,423 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"github.com/arulkarim/golden-architecture/internal/domain/entity"
 )
 
-// TodoRepository defines the interface for todo data operations
+// TodoRepository defines the interface for todo data operations.
+// Every lookup is scoped to the todos visible to the user: their own and
+// those in projects they are a member of. Other todos are reported as
+// domain.ErrNotFound; callers must check the member's role before changing
+// a todo. Deleted todos are kept in their owner's trash and are invisible
+// to every method except the trash methods.
 type TodoRepository interface {
-	// Create creates a new todo item
+	// Create creates a new todo item owned by todo.UserID, placed after the
+	// other todos of its list unless todo.Position is set. It must run
+	// within a transaction.
 	Create(ctx context.Context, todo *entity.Todo) error
 
-	// FindByID finds a todo by its ID
-	FindByID(ctx context.Context, id uint) (*entity.Todo, error)
+	// FindByID finds a todo by its ID visible to the given user
+	FindByID(ctx context.Context, userID, id uint) (*entity.Todo, error)
 
-	// FindAll retrieves all todos
-	FindAll(ctx context.Context) ([]entity.Todo, error)
+	// FindAll retrieves one page of todos matching the query together
+	// with the total number of matching todos
+	FindAll(ctx context.Context, query TodoQuery) ([]entity.Todo, int64, error)
 
-	// Update updates an existing todo
+	// FindByCursor retrieves up to query.Limit todos around a keyset
+	// position, newest first, and reports whether more todos exist beyond them
+	FindByCursor(ctx context.Context, query TodoCursorQuery) ([]entity.Todo, bool, error)
+
+	// Search retrieves one page of todos matching a full-text query, best
+	// match first, together with the total number of matches
+	Search(ctx context.Context, query TodoSearchQuery) ([]TodoSearchResult, int64, error)
+
+	// Update updates an existing todo owned by todo.UserID and advances its
+	// version. It fails with domain.ErrVersionConflict unless the stored todo
+	// is still at todo.Version.
 	Update(ctx context.Context, todo *entity.Todo) error
 
-	// Delete deletes a todo by its ID
+	// Touch advances the version of a todo after a change to its tags or
+	// checklist, so cached representations of it are invalidated
+	Touch(ctx context.Context, id uint) error
+
+	// Delete moves a todo visible to the given user to the trash. A non-zero
+	// version fails the delete with domain.ErrVersionConflict unless the
+	// todo is still at that version.
+	Delete(ctx context.Context, userID, id, version uint) error
+
+	// FindTrashed retrieves one page of the user's trashed todos, most
+	// recently deleted first, together with the total number in the trash
+	FindTrashed(ctx context.Context, userID uint, limit, offset int) ([]entity.Todo, int64, error)
+
+	// Restore moves a trashed todo owned by the given user out of the trash
+	Restore(ctx context.Context, userID, id uint) error
+
+	// DeletePermanently removes a trashed todo owned by the given user for
+	// good and returns it
+	DeletePermanently(ctx context.Context, userID, id uint) (*entity.Todo, error)
+
+	// LockTrashed returns the IDs of the todos of every user that were
+	// trashed before the given time and locks them until the surrounding
+	// transaction ends
+	LockTrashed(ctx context.Context, before time.Time) ([]uint, error)
+
+	// PurgeTrashed permanently removes the todos of every user that were
+	// trashed before the given time and returns them
+	PurgeTrashed(ctx context.Context, before time.Time) ([]entity.Todo, error)
+
+	// AttachTags links tags to a todo, ignoring tags already attached
+	AttachTags(ctx context.Context, todoID uint, tagIDs []uint) error
+
+	// DetachTag unlinks a tag from a todo
+	DetachTag(ctx context.Context, todoID, tagID uint) error
+
+	// FindInBatches calls fn with every todo matching the filter, in ID
+	// order, batchSize todos at a time. It stops at the first error from fn.
+	FindInBatches(ctx context.Context, filter TodoFilter, batchSize int, fn func([]entity.Todo) error) error
+
+	// FindByIDs finds the todos with the given IDs visible to the given user.
+	// Missing todos are left out of the result.
+	FindByIDs(ctx context.Context, userID uint, ids []uint) ([]entity.Todo, error)
+
+	// FindByTitles finds the todos owned by the user having any of the given
+	// titles, without their tags or progress
+	FindByTitles(ctx context.Context, userID uint, titles []string) ([]entity.Todo, error)
+
+	// CreateBatch creates several todos with a single statement, placing
+	// those without a position after the other todos of their lists in
+	// slice order. It must run within a transaction.
+	CreateBatch(ctx context.Context, todos []entity.Todo) error
+
+	// UpdateBatch updates several todos like Update, stopping at the first
+	// todo that cannot be updated
+	UpdateBatch(ctx context.Context, todos []entity.Todo) error
+
+	// DeleteBatch moves several todos visible to the given user to the trash
+	// with a single statement. It fails with domain.ErrNotFound unless every
+	// todo was found.
+	DeleteBatch(ctx context.Context, userID uint, ids []uint) error
+
+	// LockList serialises changes to the positions of a list until the
+	// surrounding transaction ends. Create, CreateBatch and AppendPosition
+	// take the lock themselves.
+	LockList(ctx context.Context, list TodoList) error
+
+	// FindPosition returns a todo visible to the given user with only its
+	// ID, owner, project and position set
+	FindPosition(ctx context.Context, userID, id uint) (*entity.Todo, error)
+
+	// AdjacentPosition returns the closest position after (next) or before
+	// the given one among the todos of a list but excludeID, or an empty
+	// string when there is none
+	AdjacentPosition(ctx context.Context, list TodoList, position string, excludeID uint, next bool) (string, error)
+
+	// AppendPosition locks the list of todo.ProjectID and sets todo.Position
+	// after the todos already in it, without writing the todo. Callers
+	// moving a todo to another list use it before Update.
+	AppendPosition(ctx context.Context, todo *entity.Todo) error
+
+	// Reposition moves a todo owned by todo.UserID to todo.Position and
+	// advances its version, failing like Update when it is not at
+	// todo.Version
+	Reposition(ctx context.Context, todo *entity.Todo) error
+
+	// MoveToInbox moves every todo of a project, trashed ones included, to
+	// its owner's inbox after the todos already there, keeping their order.
+	// It must run within a transaction.
+	MoveToInbox(ctx context.Context, projectID uint) error
+
+	// ListsOverLength returns the lists holding a position longer than
+	// maxLength
+	ListsOverLength(ctx context.Context, maxLength int) ([]TodoList, error)
+
+	// Rebalance gives every todo of a list a new, short position in the
+	// current order and returns the number of todos. It must run within a
+	// transaction.
+	Rebalance(ctx context.Context, list TodoList) (int, error)
+}
+
+// ChecklistRepository defines the interface for checklist item data
+// operations. Items are addressed through their todo; callers must check
+// that the todo itself is accessible.
+type ChecklistRepository interface {
+	// Create creates a new checklist item
+	Create(ctx context.Context, item *entity.ChecklistItem) error
+
+	// FindByID finds a checklist item of a todo by its ID
+	FindByID(ctx context.Context, todoID, id uint) (*entity.ChecklistItem, error)
+
+	// FindByTodo retrieves all checklist items of a todo ordered by position
+	FindByTodo(ctx context.Context, todoID uint) ([]entity.ChecklistItem, error)
+
+	// Update updates an existing checklist item
+	Update(ctx context.Context, item *entity.ChecklistItem) error
+
+	// Delete deletes a checklist item of a todo by its ID
+	Delete(ctx context.Context, todoID, id uint) error
+
+	// Reorder assigns positions to the todo's items following the order of ids
+	Reorder(ctx context.Context, todoID uint, ids []uint) error
+}
+
+// CommentRepository defines the interface for comment data operations.
+// Comments are addressed through their todo; callers must check that the
+// todo itself is accessible.
+type CommentRepository interface {
+	// Create creates a new comment
+	Create(ctx context.Context, comment *entity.Comment) error
+
+	// FindByID finds a comment of a todo by its ID, with its author's email
+	FindByID(ctx context.Context, todoID, id uint) (*entity.Comment, error)
+
+	// FindByTodo retrieves one page of a todo's comments, oldest first and
+	// with their authors' emails, together with the total number of comments
+	FindByTodo(ctx context.Context, todoID uint, limit, offset int) ([]entity.Comment, int64, error)
+
+	// Update updates the body and edit time of an existing comment
+	Update(ctx context.Context, comment *entity.Comment) error
+
+	// Delete soft-deletes a comment of a todo by its ID
+	Delete(ctx context.Context, todoID, id uint) error
+}
+
+// AttachmentRepository defines the interface for attachment metadata.
+// Attachments are addressed through their todo; callers must check that
+// the todo itself is accessible and keep the blobs in step.
+type AttachmentRepository interface {
+	// Create creates a new attachment
+	Create(ctx context.Context, attachment *entity.Attachment) error
+
+	// FindByID finds an attachment of a todo by its ID
+	FindByID(ctx context.Context, todoID, id uint) (*entity.Attachment, error)
+
+	// FindByTodo retrieves all attachments of a todo, oldest first
+	FindByTodo(ctx context.Context, todoID uint) ([]entity.Attachment, error)
+
+	// Delete deletes an attachment of a todo by its ID and returns it
+	Delete(ctx context.Context, todoID, id uint) (*entity.Attachment, error)
+
+	// DeleteByTodos deletes every attachment of the given todos and returns
+	// their storage keys
+	DeleteByTodos(ctx context.Context, todoIDs []uint) ([]string, error)
+}
+
+// TodoDependencyRepository defines the interface for blocked-by links
+// between todos. Callers must check that the todos are accessible.
+type TodoDependencyRepository interface {
+	// LockGraph serializes changes to the links until the surrounding
+	// transaction ends, so concurrent links cannot close a cycle together
+	LockGraph(ctx context.Context) error
+
+	// DependsOn reports whether a todo is blocked by another, directly or
+	// through a chain of blockers
+	DependsOn(ctx context.Context, todoID, blockerID uint) (bool, error)
+
+	// Create links a todo to its blocker, ignoring an existing link
+	Create(ctx context.Context, todoID, blockerID uint) error
+
+	// Delete unlinks a todo from its blocker
+	Delete(ctx context.Context, todoID, blockerID uint) error
+
+	// FindGraph retrieves the links among the todos a todo is transitively
+	// blocked by or transitively blocks, at most limit of them
+	FindGraph(ctx context.Context, todoID uint, limit int) ([]entity.TodoDependency, error)
+
+	// FindBlocked returns those of the given todos that have a blocker
+	// which is neither completed nor in the trash
+	FindBlocked(ctx context.Context, todoIDs []uint) ([]uint, error)
+}
+
+// TimeEntryRepository defines the interface for time tracked on todos.
+// Entries are addressed through their todo; callers must check that the
+// todo itself is accessible.
+type TimeEntryRepository interface {
+	// Create creates a new time entry. It fails with
+	// domain.ErrDuplicateEntry when the entry is running and the user
+	// already has a running timer.
+	Create(ctx context.Context, entry *entity.TimeEntry) error
+
+	// FindByID finds a time entry of a todo by its ID, with its user's email
+	FindByID(ctx context.Context, todoID, id uint) (*entity.TimeEntry, error)
+
+	// FindByTodo retrieves one page of a todo's time entries, latest first
+	// and with their users' emails, together with the total number of entries
+	FindByTodo(ctx context.Context, todoID uint, limit, offset int) ([]entity.TimeEntry, int64, error)
+
+	// TotalByTodo sums the time tracked on a todo by every user, running
+	// timers up to now
+	TotalByTodo(ctx context.Context, todoID uint) (time.Duration, error)
+
+	// FindRunning finds the user's running timer and locks it until the
+	// surrounding transaction ends
+	FindRunning(ctx context.Context, userID uint) (*entity.TimeEntry, error)
+
+	// Update updates the start, end and note of an existing time entry
+	Update(ctx context.Context, entry *entity.TimeEntry) error
+
+	// Delete deletes a time entry of a todo by its ID
+	Delete(ctx context.Context, todoID, id uint) error
+
+	// Report sums a user's tracked time per group, together with the
+	// overall total, which is less than the sum of the groups when a todo
+	// falls into several of them
+	Report(ctx context.Context, query TimeReportQuery) ([]TimeTotal, time.Duration, error)
+}
+
+// ReminderRepository defines the interface for todo reminder data
+// operations. Reminders are private to the user they remind; their fire
+// time is computed from the todo for reminders relative to its due date.
+type ReminderRepository interface {
+	// Create creates a new reminder
+	Create(ctx context.Context, reminder *entity.Reminder) error
+
+	// FindByID finds one of the user's reminders on a todo by its ID, with
+	// its fire time
+	FindByID(ctx context.Context, userID, todoID, id uint) (*entity.Reminder, error)
+
+	// FindByTodo retrieves the user's reminders on a todo with their fire
+	// times, soonest first and reminders without one last
+	FindByTodo(ctx context.Context, userID, todoID uint) ([]entity.Reminder, error)
+
+	// Delete deletes one of the user's reminders on a todo by its ID
+	Delete(ctx context.Context, userID, todoID, id uint) error
+
+	// CopyRelative copies the reminders relative to the due date of one
+	// todo to another, such as the next occurrence of a recurring todo
+	CopyRelative(ctx context.Context, fromTodoID, toTodoID uint) error
+
+	// ClaimDue retrieves up to limit reminders that are due at now and not
+	// waiting for a retry, on open todos, with their fire time, todo title
+	// and user email, and leases them: their attempts advance and their
+	// retry time moves to now plus lease, so no scheduler claims them again
+	// until the lease ends. Reminders locked by another transaction are
+	// skipped, so several schedulers can share the work. It must run within
+	// a short transaction of its own.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.Reminder, error)
+
+	// UpdateDelivery updates the delivery state of a reminder: when it was
+	// sent, its attempts, retry time, last error and delivered channels. It
+	// fails with domain.ErrVersionConflict unless the stored reminder is
+	// still at the given attempt, that is, it was not claimed again since.
+	UpdateDelivery(ctx context.Context, reminder *entity.Reminder, attempt int) error
+}
+
+// TodoEventRepository defines the interface for the todo audit trail.
+// Events are append-only; callers must check that the todo is accessible.
+type TodoEventRepository interface {
+	// Create appends an event
+	Create(ctx context.Context, event *entity.TodoEvent) error
+
+	// CreateBatch appends several events with a single statement
+	CreateBatch(ctx context.Context, events []entity.TodoEvent) error
+
+	// FindByTodo retrieves one page of a todo's events, newest first,
+	// along with the total number of events
+	FindByTodo(ctx context.Context, todoID uint, limit, offset int) ([]entity.TodoEvent, int64, error)
+
+	// FindAfter retrieves the events of a todo that produced a version
+	// newer than the given one, newest first
+	FindAfter(ctx context.Context, todoID, version uint) ([]entity.TodoEvent, error)
+
+	// FindFirst finds the oldest event of a todo
+	FindFirst(ctx context.Context, todoID uint) (*entity.TodoEvent, error)
+}
+
+// TagRepository defines the interface for tag data operations.
+// Tags are scoped to their owning user like todos.
+type TagRepository interface {
+	// Create creates a new tag owned by tag.UserID
+	Create(ctx context.Context, tag *entity.Tag) error
+
+	// FindByID finds a tag by its ID owned by the given user
+	FindByID(ctx context.Context, userID, id uint) (*entity.Tag, error)
+
+	// FindByIDs finds the tags with the given IDs owned by the given user,
+	// silently skipping IDs that do not match
+	FindByIDs(ctx context.Context, userID uint, ids []uint) ([]entity.Tag, error)
+
+	// FindAll retrieves all tags owned by the given user ordered by name
+	FindAll(ctx context.Context, userID uint) ([]entity.Tag, error)
+
+	// Update updates an existing tag owned by tag.UserID
+	Update(ctx context.Context, tag *entity.Tag) error
+
+	// Delete deletes a tag by its ID owned by the given user
+	Delete(ctx context.Context, userID, id uint) error
+}
+
+// ProjectRepository defines the interface for project data operations.
+// Lookups are scoped to the projects the user is a member of; callers must
+// check the member's role before changing a project.
+type ProjectRepository interface {
+	// Create creates a new project owned by project.UserID
+	Create(ctx context.Context, project *entity.Project) error
+
+	// FindByID finds a project by its ID the given user is a member of
+	FindByID(ctx context.Context, userID, id uint) (*entity.Project, error)
+
+	// FindAll retrieves the projects the given user is a member of ordered
+	// by name, leaving out archived ones unless includeArchived is set
+	FindAll(ctx context.Context, userID uint, includeArchived bool) ([]entity.Project, error)
+
+	// Update updates an existing project
+	Update(ctx context.Context, project *entity.Project) error
+
+	// Delete deletes a project by its ID. Callers move its todos out with
+	// TodoRepository.MoveToInbox first.
 	Delete(ctx context.Context, id uint) error
+
+	// FindWorkflow returns the workflow of a project by its ID regardless of
+	// membership, or the default workflow when the project has none
+	FindWorkflow(ctx context.Context, id uint) (entity.Workflow, error)
+
+	// FitStatuses fits the project's todos, trashed ones included, to a
+	// workflow: todos in a status it lacks move to its done or initial
+	// status, completed follows the status and changed todos advance
+	// their version
+	FitStatuses(ctx context.Context, id uint, workflow entity.Workflow) error
+}
+
+// ProjectMemberRepository defines the interface for project memberships
+type ProjectMemberRepository interface {
+	// Create adds a member to a project. It fails with
+	// domain.ErrDuplicateEntry when the user is already a member.
+	Create(ctx context.Context, member *entity.ProjectMember) error
+
+	// Find finds the membership of a user in a project
+	Find(ctx context.Context, projectID, userID uint) (*entity.ProjectMember, error)
+
+	// FindByProject retrieves the members of a project with their email,
+	// oldest first
+	FindByProject(ctx context.Context, projectID uint) ([]entity.ProjectMember, error)
+
+	// Update changes the role of a member
+	Update(ctx context.Context, member *entity.ProjectMember) error
+
+	// Delete removes a member from a project
+	Delete(ctx context.Context, projectID, userID uint) error
+
+	// CountOwners counts the owners of a project
+	CountOwners(ctx context.Context, projectID uint) (int64, error)
+}
+
+// ProjectInvitationRepository defines the interface for pending project
+// invitations. Emails are stored lowercase.
+type ProjectInvitationRepository interface {
+	// Create stores an invitation. It fails with domain.ErrDuplicateEntry
+	// when the email is already invited to the project.
+	Create(ctx context.Context, invitation *entity.ProjectInvitation) error
+
+	// FindByProject retrieves the pending invitations of a project, oldest first
+	FindByProject(ctx context.Context, projectID uint) ([]entity.ProjectInvitation, error)
+
+	// Delete deletes an invitation to a project by its ID
+	Delete(ctx context.Context, projectID, id uint) error
+
+	// Accept turns the pending invitations for an email into memberships
+	// of the given user and deletes them
+	Accept(ctx context.Context, email string, userID uint) error
 }
 
 // UserRepository defines the interface for user data operations
@@ -29,9 +426,49 @@ type UserRepository interface {
 	// Create creates a new user
 	Create(ctx context.Context, user *entity.User) error
 
-	// FindByEmail finds a user by email
+	// FindByEmail finds a user by email, ignoring case. Emails are unique
+	// regardless of case.
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 
 	// FindByID finds a user by ID
 	FindByID(ctx context.Context, id uint) (*entity.User, error)
 }
+
+// NotificationRepository defines the interface for notification data operations
+type NotificationRepository interface {
+	// CreateBatch creates several notifications with a single statement
+	CreateBatch(ctx context.Context, notifications []entity.Notification) error
+
+	// FindByUser retrieves one page of the user's notifications, newest
+	// first and only unread ones when unreadOnly is set, together with the
+	// total number of them
+	FindByUser(ctx context.Context, userID uint, unreadOnly bool, limit, offset int) ([]entity.Notification, int64, error)
+
+	// MarkRead marks one of the user's notifications as read
+	MarkRead(ctx context.Context, userID, id uint) (*entity.Notification, error)
+
+	// MarkAllRead marks all of the user's unread notifications as read and
+	// returns how many there were
+	MarkAllRead(ctx context.Context, userID uint) (int64, error)
+}
+
+// TemplateRepository defines the interface for todo template data
+// operations. Templates are private to the user who owns them.
+type TemplateRepository interface {
+	// Create creates a new template owned by template.UserID. It fails with
+	// domain.ErrDuplicateEntry when the user has a template of that name.
+	Create(ctx context.Context, template *entity.Template) error
+
+	// FindByID finds a template by its ID owned by the given user
+	FindByID(ctx context.Context, userID, id uint) (*entity.Template, error)
+
+	// FindAll retrieves all templates owned by the given user ordered by name
+	FindAll(ctx context.Context, userID uint) ([]entity.Template, error)
+
+	// Update updates an existing template owned by template.UserID. It
+	// fails with domain.ErrDuplicateEntry when renaming to a taken name.
+	Update(ctx context.Context, template *entity.Template) error
+
+	// Delete deletes a template by its ID owned by the given user
+	Delete(ctx context.Context, userID, id uint) error
+}
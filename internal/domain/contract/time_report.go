@@ -0,0 +1,32 @@
+package contract
+
+import (
+	"time"
+)
+
+// Groupings accepted by TimeEntryRepository.Report
+const (
+	TimeByDay     = "day"
+	TimeByProject = "project"
+	TimeByTag     = "tag"
+)
+
+// TimeReportQuery selects the time entries of a user started in
+// [From, Before) and how to group them. Days are calendar days in Location.
+// Running timers count up to now.
+type TimeReportQuery struct {
+	UserID   uint
+	From     time.Time
+	Before   time.Time
+	GroupBy  string
+	Location *time.Location
+}
+
+// TimeTotal is the time tracked in one group of a report. Key is the day
+// as YYYY-MM-DD, or the name of the project or tag; ID is the project or
+// tag ID and nil for days, the inbox and untagged todos.
+type TimeTotal struct {
+	Key      string
+	ID       *uint
+	Duration time.Duration
+}
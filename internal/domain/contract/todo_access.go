@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// TodoAccess finds todos on behalf of a user for the modules that hang
+// data off todos, such as comments and attachments. Todos the user cannot
+// see are reported as domain.ErrNotFound.
+type TodoAccess interface {
+	// FindVisible finds a todo the user owns or shares a project with
+	FindVisible(ctx context.Context, userID, todoID uint) (*entity.Todo, error)
+
+	// FindWritable finds a todo visible to the user and checks that they
+	// may change it, returning domain.ErrForbidden when their project role
+	// only lets them read it
+	FindWritable(ctx context.Context, userID, todoID uint) (*entity.Todo, error)
+}
@@ -0,0 +1,114 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// Sort fields accepted by TodoRepository.FindAll
+const (
+	TodoSortCreatedAt = "created_at"
+	TodoSortUpdatedAt = "updated_at"
+	TodoSortTitle     = "title"
+	TodoSortDueAt     = "due_at"
+	TodoSortPriority  = "priority"
+	TodoSortPosition  = "position"
+)
+
+// Tag match modes for TodoFilter.TagMatch
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
+// Sort directions
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// TodoFilter narrows down the todos returned by a query.
+// DueFrom is inclusive and DueBefore exclusive; todos without a due date
+// never match a due date bound. Tags holds tag names; TagMatch selects
+// whether a todo needs any (default) or all of them. ProjectID restricts
+// the todos to one project and Status to one workflow status. Ready keeps
+// the open todos without open blockers.
+type TodoFilter struct {
+	UserID      uint
+	ProjectID   *uint
+	Status      string
+	Completed   *bool
+	Priority    entity.TodoPriority
+	Tags        []string
+	TagMatch    string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	DueFrom     *time.Time
+	DueBefore   *time.Time
+	Ready       bool
+}
+
+// TodoQuery describes a filtered, sorted and paginated todo listing
+type TodoQuery struct {
+	TodoFilter
+	SortBy    string
+	SortOrder string
+	Limit     int
+	Offset    int
+}
+
+// TodoKey identifies a todo's position in the newest-first keyset ordering
+type TodoKey struct {
+	CreatedAt time.Time
+	ID        uint
+}
+
+// TodoCursorQuery describes a keyset-paginated todo listing ordered by
+// (created_at, id) descending. At most one of After and Before is set:
+// After returns the todos following the key, Before the todos preceding it.
+type TodoCursorQuery struct {
+	TodoFilter
+	After  *TodoKey
+	Before *TodoKey
+	Limit  int
+}
+
+// TodoSearchQuery describes a ranked full-text search over a user's todos.
+// Query supports plain words (all must match), trailing-* prefixes and
+// double-quoted phrases.
+type TodoSearchQuery struct {
+	UserID uint
+	Query  string
+	Limit  int
+	Offset int
+}
+
+// TodoSearchResult is a todo matched by a full-text search.
+// Highlights are HTML-escaped snippets with matching terms wrapped in
+// <mark></mark>.
+type TodoSearchResult struct {
+	Todo                 entity.Todo
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// TodoList identifies one manually ordered list of todos: the todos of a
+// project, or the inbox of one owner's todos outside any project when
+// ProjectID is nil. UserID is ignored for project lists. Positions are
+// unique within a list, trashed todos included.
+type TodoList struct {
+	UserID    uint
+	ProjectID *uint
+}
+
+// TodoListOf returns the list a todo is ordered in
+func TodoListOf(todo *entity.Todo) TodoList {
+	if todo.ProjectID != nil {
+		return TodoList{ProjectID: todo.ProjectID}
+	}
+	return TodoList{UserID: todo.UserID}
+}
@@ -0,0 +1,12 @@
+package contract
+
+import "context"
+
+// Transactor runs units of work atomically.
+// Repositories called with the context handed to fn take part in the
+// transaction; nested calls run inside the outer transaction.
+type Transactor interface {
+	// WithinTransaction runs fn in a transaction that is committed when fn
+	// returns nil and rolled back otherwise
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
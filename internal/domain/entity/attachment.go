@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+)
+
+// Attachment is a file attached to a todo. Its content lives in blob
+// storage under StorageKey; ContentType is sniffed from the content when
+// it is uploaded.
+type Attachment struct {
+	ID          uint      `gorm:"primaryKey"`
+	TodoID      uint      `gorm:"not null;index"`
+	UserID      uint      `gorm:"not null"`
+	Filename    string    `gorm:"size:255;not null"`
+	ContentType string    `gorm:"size:127;not null"`
+	Size        int64     `gorm:"not null"`
+	StorageKey  string    `gorm:"size:255;not null;uniqueIndex"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for Attachment
+func (Attachment) TableName() string {
+	return "attachments"
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+)
+
+// ChecklistItem represents an ordered subtask of a todo
+type ChecklistItem struct {
+	ID          uint   `gorm:"primaryKey"`
+	TodoID      uint   `gorm:"not null;index"`
+	Title       string `gorm:"size:255;not null"`
+	Completed   bool   `gorm:"default:false"`
+	Position    int    `gorm:"not null;default:0"`
+	CompletedAt *time.Time
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for ChecklistItem
+func (ChecklistItem) TableName() string {
+	return "checklist_items"
+}
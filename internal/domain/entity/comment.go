@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Comment is a markdown message in the discussion thread of a todo.
+// EditedAt is set when the author changes the body; deleted comments are
+// soft-deleted and hidden from the thread.
+type Comment struct {
+	ID        uint   `gorm:"primaryKey"`
+	TodoID    uint   `gorm:"not null;index"`
+	UserID    uint   `gorm:"not null"`
+	Body      string `gorm:"type:text;not null"`
+	EditedAt  *time.Time
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	// AuthorEmail is read from the author's user account
+	AuthorEmail string `gorm:"->;-:migration"`
+}
+
+// TableName specifies the table name for Comment
+func (Comment) TableName() string {
+	return "comments"
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+)
+
+// TodoDependency records that a todo is blocked by another todo, its
+// blocker, and should not be completed while the blocker is open
+type TodoDependency struct {
+	TodoID    uint      `gorm:"primaryKey"`
+	BlockerID uint      `gorm:"primaryKey;index"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for TodoDependency
+func (TodoDependency) TableName() string {
+	return "todo_dependencies"
+}
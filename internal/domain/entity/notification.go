@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+)
+
+// NotificationType is the kind of event a notification tells a user about
+type NotificationType string
+
+const (
+	// NotificationMention tells a user they were @mentioned in a comment
+	NotificationMention NotificationType = "mention"
+	// NotificationReminder delivers one of the user's todo reminders
+	NotificationReminder NotificationType = "reminder"
+)
+
+// Notification tells a user about something that happened to a todo they
+// can see. ActorID is the user who caused it, CommentID the comment it
+// refers to and ReminderID the reminder it delivers, if any. ReadAt is set
+// once the user has read it.
+type Notification struct {
+	ID         uint `gorm:"primaryKey"`
+	UserID     uint `gorm:"not null;index"`
+	ActorID    *uint
+	Type       NotificationType `gorm:"size:32;not null"`
+	TodoID     uint             `gorm:"not null"`
+	CommentID  *uint
+	ReminderID *uint
+	ReadAt     *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for Notification
+func (Notification) TableName() string {
+	return "notifications"
+}
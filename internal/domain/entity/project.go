@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+)
+
+// Project groups a user's todos. Todos outside of any project are in the
+// user's inbox. DefaultSort and DefaultOrder are the sort field and
+// direction used to list the project's todos when none is requested.
+// Archived projects are hidden from listings by default and accept no new
+// todos. Workflow defines the statuses of the project's todos; when empty
+// the default workflow applies.
+type Project struct {
+	ID           uint      `gorm:"primaryKey"`
+	UserID       uint      `gorm:"not null;uniqueIndex:idx_projects_user_name"`
+	Name         string    `gorm:"size:64;not null;uniqueIndex:idx_projects_user_name"`
+	Color        string    `gorm:"size:7"`
+	Archived     bool      `gorm:"not null;default:false"`
+	DefaultSort  string    `gorm:"size:32;not null;default:created_at"`
+	DefaultOrder string    `gorm:"size:4;not null;default:desc"`
+	Workflow     Workflow  `gorm:"type:jsonb"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for Project
+func (Project) TableName() string {
+	return "projects"
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"time"
+)
+
+// ProjectRole is the permission level of a project member
+type ProjectRole string
+
+// Supported project roles, from least to most privileged. Viewers can read
+// the project's todos, editors can also change them and owners can also
+// manage the project and its members.
+const (
+	RoleViewer ProjectRole = "viewer"
+	RoleEditor ProjectRole = "editor"
+	RoleOwner  ProjectRole = "owner"
+)
+
+// rank orders the roles by privilege
+func (r ProjectRole) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleOwner:
+		return 3
+	}
+	return 0
+}
+
+// IsValid checks whether the role is one of the supported values
+func (r ProjectRole) IsValid() bool {
+	return r.rank() > 0
+}
+
+// Allows reports whether the role grants at least the permissions of required
+func (r ProjectRole) Allows(required ProjectRole) bool {
+	return r.IsValid() && r.rank() >= required.rank()
+}
+
+// ProjectMember grants a user a role in a project. The project's creator
+// is its first owner. Email is read from the user when listing members.
+type ProjectMember struct {
+	ProjectID uint        `gorm:"primaryKey"`
+	UserID    uint        `gorm:"primaryKey;index"`
+	Role      ProjectRole `gorm:"size:16;not null"`
+	Email     string      `gorm:"->;-:migration"`
+	CreatedAt time.Time   `gorm:"autoCreateTime"`
+	UpdatedAt time.Time   `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for ProjectMember
+func (ProjectMember) TableName() string {
+	return "project_members"
+}
+
+// ProjectInvitation is a pending membership for an email address that has
+// no account yet. It turns into a membership when the invitee registers.
+type ProjectInvitation struct {
+	ID        uint        `gorm:"primaryKey"`
+	ProjectID uint        `gorm:"not null;uniqueIndex:idx_project_invitations_project_email"`
+	Email     string      `gorm:"size:255;not null;uniqueIndex:idx_project_invitations_project_email;index"`
+	Role      ProjectRole `gorm:"size:16;not null"`
+	InvitedBy uint        `gorm:"not null"`
+	CreatedAt time.Time   `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for ProjectInvitation
+func (ProjectInvitation) TableName() string {
+	return "project_invitations"
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "testing"
+
+func TestProjectRoleAllows(t *testing.T) {
+	tests := []struct {
+		role     ProjectRole
+		required ProjectRole
+		want     bool
+	}{
+		{role: RoleOwner, required: RoleOwner, want: true},
+		{role: RoleOwner, required: RoleEditor, want: true},
+		{role: RoleOwner, required: RoleViewer, want: true},
+		{role: RoleEditor, required: RoleOwner, want: false},
+		{role: RoleEditor, required: RoleEditor, want: true},
+		{role: RoleEditor, required: RoleViewer, want: true},
+		{role: RoleViewer, required: RoleEditor, want: false},
+		{role: RoleViewer, required: RoleViewer, want: true},
+		{role: "admin", required: RoleViewer, want: false},
+		{role: "", required: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" "+string(tt.required), func(t *testing.T) {
+			if got := tt.role.Allows(tt.required); got != tt.want {
+				t.Errorf("%q.Allows(%q) = %v, want %v", tt.role, tt.required, got, tt.want)
+			}
+		})
+	}
+}
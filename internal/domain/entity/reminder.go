@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+)
+
+// Reminder reminds a user of a todo, either at a fixed time (RemindAt) or
+// MinutesBefore the todo's due date. A reminder fires once its fire time
+// has come and it has not been sent since, so moving the due date re-arms
+// a relative reminder. Attempts counts the deliveries started; RetryAt
+// holds the end of the lease while one runs and, after it failed, when the
+// next begins. The channels in Delivered already have the reminder and are
+// skipped on retries. A reminder given up on is marked sent with its LastError kept.
+type Reminder struct {
+	ID            uint `gorm:"primaryKey"`
+	TodoID        uint `gorm:"not null;index"`
+	UserID        uint `gorm:"not null;index"`
+	RemindAt      *time.Time
+	MinutesBefore *int
+	SentAt        *time.Time
+	Attempts      int `gorm:"not null;default:0"`
+	RetryAt       *time.Time
+	LastError     string    `gorm:"type:text;not null;default:''"`
+	Delivered     TextList  `gorm:"type:jsonb;not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+
+	// FireAt is when the reminder is due, computed from the todo's due
+	// date for relative reminders; nil while the todo has no due date
+	FireAt *time.Time `gorm:"->;-:migration"`
+	// TodoTitle and UserEmail are read from the todo and the reminded user
+	TodoTitle string `gorm:"->;-:migration"`
+	UserEmail string `gorm:"->;-:migration"`
+}
+
+// TableName specifies the table name for Reminder
+func (Reminder) TableName() string {
+	return "reminders"
+}
+
+// Pending reports whether the reminder has yet to be sent for its current
+// fire time
+func (r *Reminder) Pending() bool {
+	return r.SentAt == nil || (r.FireAt != nil && r.SentAt.Before(*r.FireAt))
+}
+
+// Failed reports whether the reminder was given up on after failed deliveries
+func (r *Reminder) Failed() bool {
+	return !r.Pending() && r.LastError != ""
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+)
+
+// Tag represents a label a user can attach to their todos
+type Tag struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_tags_user_name"`
+	Name      string    `gorm:"size:64;not null;uniqueIndex:idx_tags_user_name"`
+	Color     string    `gorm:"size:7"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for Tag
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// TodoTag links a todo to one of its tags
+type TodoTag struct {
+	TodoID uint `gorm:"primaryKey"`
+	TagID  uint `gorm:"primaryKey;index"`
+}
+
+// TableName specifies the table name for TodoTag
+func (TodoTag) TableName() string {
+	return "todo_tags"
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// TextList is a list of strings stored as a JSONB array
+type TextList []string
+
+// Value implements driver.Valuer
+func (l TextList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (l *TextList) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*l = nil
+		return nil
+	default:
+		return errors.New("unsupported text list value")
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
+
+// Template is a reusable blueprint of a todo owned by a user. Title,
+// Description, Items (checklist item titles) and Tags (tag names) may hold
+// {{name}} placeholders filled in when the template is instantiated.
+// DueOffset is the due date relative to the instantiation, such as "+3d"
+// or "+1w2d"; when empty the todo has no due date.
+type Template struct {
+	ID          uint         `gorm:"primaryKey"`
+	UserID      uint         `gorm:"not null;uniqueIndex:idx_templates_user_name"`
+	Name        string       `gorm:"size:64;not null;uniqueIndex:idx_templates_user_name"`
+	Title       string       `gorm:"size:255;not null"`
+	Description string       `gorm:"type:text"`
+	Priority    TodoPriority `gorm:"size:16;not null;default:medium"`
+	DueOffset   string       `gorm:"size:32;not null;default:''"`
+	Items       TextList     `gorm:"type:jsonb;not null"`
+	Tags        TextList     `gorm:"type:jsonb;not null"`
+	CreatedAt   time.Time    `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time    `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for Template
+func (Template) TableName() string {
+	return "templates"
+}
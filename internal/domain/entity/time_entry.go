@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"time"
+)
+
+// TimeEntry is a span of time a user spent on a todo, either tracked with
+// a timer or entered by hand. EndedAt is nil while the timer is running; a
+// user has at most one running timer.
+type TimeEntry struct {
+	ID        uint      `gorm:"primaryKey"`
+	TodoID    uint      `gorm:"not null;index"`
+	UserID    uint      `gorm:"not null;index:idx_time_entries_user_started_at,priority:1;uniqueIndex:idx_time_entries_running,where:ended_at IS NULL"`
+	StartedAt time.Time `gorm:"not null;index:idx_time_entries_user_started_at,priority:2"`
+	EndedAt   *time.Time
+	Note      string    `gorm:"type:text;not null;default:''"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	// UserEmail is read from the tracking user's account
+	UserEmail string `gorm:"->;-:migration"`
+}
+
+// TableName specifies the table name for TimeEntry
+func (TimeEntry) TableName() string {
+	return "time_entries"
+}
+
+// Running reports whether the entry's timer is still running
+func (e *TimeEntry) Running() bool {
+	return e.EndedAt == nil
+}
+
+// Duration returns the time the entry spans; running entries span until now
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	end := now
+	if e.EndedAt != nil {
+		end = *e.EndedAt
+	}
+	if end.Before(e.StartedAt) {
+		return 0
+	}
+	return end.Sub(e.StartedAt)
+}
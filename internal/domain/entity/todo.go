@@ -2,16 +2,75 @@ package entity
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
-// Todo represents a todo item entity
+// TodoPriority represents how urgent a todo is
+type TodoPriority string
+
+// Supported todo priorities, from least to most urgent
+const (
+	PriorityLow    TodoPriority = "low"
+	PriorityMedium TodoPriority = "medium"
+	PriorityHigh   TodoPriority = "high"
+	PriorityUrgent TodoPriority = "urgent"
+)
+
+// IsValid checks whether the priority is one of the supported values
+func (p TodoPriority) IsValid() bool {
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// TodoProgress summarizes how many checklist items of a todo are done
+type TodoProgress struct {
+	Done  int
+	Total int
+}
+
+// Todo represents a todo item entity.
+// When AutoComplete is set, the todo completes itself once all of its
+// checklist items are done and reopens when one of them is reopened.
+// A todo with a Recurrence (an RRULE evaluated in Timezone from DueAt) is one
+// occurrence of a series; completing it creates the next occurrence, which
+// NextOccurrenceID then points to. Status is a status of the workflow of
+// the todo's project (or the default workflow in the inbox); Completed is
+// derived from it and kept for filtering. Position is the todo's rank in the
+// user's manual ordering (see pkg/rank). ProjectID is nil for todos in the
+// user's inbox. Version starts at 1 and advances on every change to the
+// todo, its tags or its checklist.
 type Todo struct {
-	ID          uint      `gorm:"primaryKey"`
-	Title       string    `gorm:"size:255;not null"`
-	Description string    `gorm:"type:text"`
-	Completed   bool      `gorm:"default:false"`
-	CreatedAt   time.Time `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	ID               uint         `gorm:"primaryKey"`
+	UserID           uint         `gorm:"not null;index"`
+	Title            string       `gorm:"size:255;not null"`
+	Description      string       `gorm:"type:text"`
+	Completed        bool         `gorm:"default:false"`
+	Status           string       `gorm:"size:32;not null;default:backlog"`
+	Priority         TodoPriority `gorm:"size:16;not null;default:medium"`
+	DueAt            *time.Time
+	CompletedAt      *time.Time
+	AutoComplete     bool   `gorm:"not null;default:false"`
+	Recurrence       string `gorm:"size:255;not null;default:''"`
+	Timezone         string `gorm:"size:64;not null;default:UTC"`
+	NextOccurrenceID *uint
+	ProjectID        *uint          `gorm:"index"`
+	Position         string         `gorm:"type:text;not null;default:''"`
+	Version          uint           `gorm:"not null;default:1"`
+	CreatedAt        time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+
+	// Tags, Progress and TrackedTime are populated by the repository when
+	// reading todos. TrackedTime sums the time entries of every user,
+	// running timers up to now; it is not versioned, so it never changes
+	// the todo's Version.
+	Tags        []Tag         `gorm:"-"`
+	Progress    TodoProgress  `gorm:"-"`
+	TrackedTime time.Duration `gorm:"-"`
 }
 
 // TableName specifies the table name for Todo
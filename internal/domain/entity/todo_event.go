@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// TodoEventAction is the kind of change a todo event records
+type TodoEventAction string
+
+const (
+	// TodoCreated records a new todo
+	TodoCreated TodoEventAction = "created"
+	// TodoUpdated records a change to a todo's fields or tags
+	TodoUpdated TodoEventAction = "updated"
+	// TodoReverted records a todo reverted to an earlier revision
+	TodoReverted TodoEventAction = "reverted"
+	// TodoDeleted records a todo moved to the trash
+	TodoDeleted TodoEventAction = "deleted"
+	// TodoRestored records a todo restored from the trash
+	TodoRestored TodoEventAction = "restored"
+	// TodoPurged records a todo deleted permanently
+	TodoPurged TodoEventAction = "purged"
+)
+
+// FieldChange holds the JSON values of a field before and after a change
+type FieldChange struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// FieldChanges maps field names to their changes. It is stored as JSONB.
+type FieldChanges map[string]FieldChange
+
+// Value implements driver.Valuer
+func (c FieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (c *FieldChanges) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*c = nil
+		return nil
+	default:
+		return errors.New("unsupported field changes value")
+	}
+	return json.Unmarshal(data, c)
+}
+
+// TodoEvent is an immutable record of a change made to a todo. Version is
+// the todo's version after the change; ActorID is nil for changes made by
+// the system, such as purging the trash.
+type TodoEvent struct {
+	ID        uint `gorm:"primaryKey"`
+	TodoID    uint `gorm:"not null;index:idx_todo_events_todo_id"`
+	UserID    uint `gorm:"not null"`
+	ActorID   *uint
+	Action    TodoEventAction `gorm:"size:16;not null"`
+	Version   uint            `gorm:"not null"`
+	Changes   FieldChanges    `gorm:"type:jsonb;not null"`
+	RequestID string          `gorm:"size:128;not null;default:''"`
+	CreatedAt time.Time       `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for TodoEvent
+func (TodoEvent) TableName() string {
+	return "todo_events"
+}
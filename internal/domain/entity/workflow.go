@@ -0,0 +1,225 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+// Statuses of the default workflow
+const (
+	StatusBacklog    = "backlog"
+	StatusInProgress = "in_progress"
+	StatusReview     = "review"
+	StatusDone       = "done"
+)
+
+const (
+	// MaxWorkflowStatuses is the largest number of statuses a workflow may have
+	MaxWorkflowStatuses = 20
+	// maxStatusKeyLength is the longest status key, matching todos.status
+	maxStatusKeyLength = 32
+	// maxStatusNameLength is the longest status display name
+	maxStatusNameLength = 64
+)
+
+// WorkflowStatus is one stage of a workflow. Todos in a Done status count
+// as completed.
+type WorkflowStatus struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+// Workflow lists the statuses of a project's todos in board order and the
+// transitions allowed between them, keyed by the status a todo leaves.
+// New todos start in the first status. A workflow without statuses stands
+// for the default one. It is stored as JSONB.
+type Workflow struct {
+	Statuses    []WorkflowStatus    `json:"statuses"`
+	Transitions map[string][]string `json:"transitions"`
+}
+
+// DefaultWorkflow returns the workflow of the inbox and of projects that
+// do not define their own: backlog → in_progress → review → done, with
+// steps back for rework and reopening
+func DefaultWorkflow() Workflow {
+	return Workflow{
+		Statuses: []WorkflowStatus{
+			{Key: StatusBacklog, Name: "Backlog"},
+			{Key: StatusInProgress, Name: "In progress"},
+			{Key: StatusReview, Name: "Review"},
+			{Key: StatusDone, Name: "Done", Done: true},
+		},
+		Transitions: map[string][]string{
+			StatusBacklog:    {StatusInProgress},
+			StatusInProgress: {StatusBacklog, StatusReview},
+			StatusReview:     {StatusInProgress, StatusDone},
+			StatusDone:       {StatusInProgress},
+		},
+	}
+}
+
+// OrDefault returns the workflow, or the default workflow when it has no
+// statuses
+func (w Workflow) OrDefault() Workflow {
+	if len(w.Statuses) == 0 {
+		return DefaultWorkflow()
+	}
+	return w
+}
+
+// Status finds a status of the workflow by its key
+func (w Workflow) Status(key string) (WorkflowStatus, bool) {
+	for _, status := range w.Statuses {
+		if status.Key == key {
+			return status, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// Initial returns the status new and reopened todos are put in
+func (w Workflow) Initial() WorkflowStatus {
+	return w.Statuses[0]
+}
+
+// DoneStatus returns the first done status, which completed todos are put in
+func (w Workflow) DoneStatus() WorkflowStatus {
+	for _, status := range w.Statuses {
+		if status.Done {
+			return status
+		}
+	}
+	return WorkflowStatus{}
+}
+
+// Allows reports whether a todo may move from one status to another
+func (w Workflow) Allows(from, to string) bool {
+	return slices.Contains(w.Transitions[from], to)
+}
+
+// Next returns the first status in board order a todo may move to from
+// the given one whose Done flag matches done
+func (w Workflow) Next(from string, done bool) (WorkflowStatus, bool) {
+	for _, status := range w.Statuses {
+		if status.Done == done && w.Allows(from, status.Key) {
+			return status, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// Fit returns the status a todo keeps in this workflow: its own status
+// when the workflow has it, otherwise the done status for completed todos
+// and the initial status for the others
+func (w Workflow) Fit(key string, completed bool) WorkflowStatus {
+	if status, ok := w.Status(key); ok {
+		return status
+	}
+	if completed {
+		return w.DoneStatus()
+	}
+	return w.Initial()
+}
+
+// IsValid checks that the workflow has between one and MaxWorkflowStatuses
+// uniquely keyed statuses, starts with an open status, has a done status
+// and only allows transitions between different statuses of its own
+func (w Workflow) IsValid() bool {
+	if len(w.Statuses) == 0 || len(w.Statuses) > MaxWorkflowStatuses || w.Statuses[0].Done {
+		return false
+	}
+
+	keys := make(map[string]bool, len(w.Statuses))
+	hasDone := false
+	for _, status := range w.Statuses {
+		if !validStatusKey(status.Key) || keys[status.Key] {
+			return false
+		}
+		if status.Name == "" || len(status.Name) > maxStatusNameLength {
+			return false
+		}
+		keys[status.Key] = true
+		hasDone = hasDone || status.Done
+	}
+	if !hasDone {
+		return false
+	}
+
+	for from, targets := range w.Transitions {
+		if !keys[from] {
+			return false
+		}
+		for _, to := range targets {
+			if !keys[to] || to == from {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// DoneKeys returns the keys of the done statuses
+func (w Workflow) DoneKeys() []string {
+	var keys []string
+	for _, status := range w.Statuses {
+		if status.Done {
+			keys = append(keys, status.Key)
+		}
+	}
+	return keys
+}
+
+// Keys returns the keys of all statuses in board order
+func (w Workflow) Keys() []string {
+	keys := make([]string, 0, len(w.Statuses))
+	for _, status := range w.Statuses {
+		keys = append(keys, status.Key)
+	}
+	return keys
+}
+
+// validStatusKey reports whether a status key is a lowercase identifier
+func validStatusKey(key string) bool {
+	if key == "" || len(key) > maxStatusKeyLength || key[0] < 'a' || key[0] > 'z' {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// Value implements driver.Valuer. The default workflow is stored as NULL.
+func (w Workflow) Value() (driver.Value, error) {
+	if len(w.Statuses) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner
+func (w *Workflow) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*w = Workflow{}
+		return nil
+	default:
+		return errors.New("unsupported workflow value")
+	}
+	return json.Unmarshal(data, w)
+}
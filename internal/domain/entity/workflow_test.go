@@ -0,0 +1,211 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWorkflowIsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow Workflow
+		want     bool
+	}{
+		{
+			name:     "default",
+			workflow: DefaultWorkflow(),
+			want:     true,
+		},
+		{
+			name: "two statuses without transitions",
+			workflow: Workflow{Statuses: []WorkflowStatus{
+				{Key: "open", Name: "Open"},
+				{Key: "closed", Name: "Closed", Done: true},
+			}},
+			want: true,
+		},
+		{
+			name:     "no statuses",
+			workflow: Workflow{},
+			want:     false,
+		},
+		{
+			name: "initial status is done",
+			workflow: Workflow{Statuses: []WorkflowStatus{
+				{Key: "closed", Name: "Closed", Done: true},
+				{Key: "open", Name: "Open"},
+			}},
+			want: false,
+		},
+		{
+			name: "no done status",
+			workflow: Workflow{Statuses: []WorkflowStatus{
+				{Key: "open", Name: "Open"},
+				{Key: "doing", Name: "Doing"},
+			}},
+			want: false,
+		},
+		{
+			name: "duplicate key",
+			workflow: Workflow{Statuses: []WorkflowStatus{
+				{Key: "open", Name: "Open"},
+				{Key: "open", Name: "Closed", Done: true},
+			}},
+			want: false,
+		},
+		{
+			name: "key not an identifier",
+			workflow: Workflow{Statuses: []WorkflowStatus{
+				{Key: "In Progress", Name: "Open"},
+				{Key: "closed", Name: "Closed", Done: true},
+			}},
+			want: false,
+		},
+		{
+			name: "key too long",
+			workflow: Workflow{Statuses: []WorkflowStatus{
+				{Key: strings.Repeat("a", maxStatusKeyLength+1), Name: "Open"},
+				{Key: "closed", Name: "Closed", Done: true},
+			}},
+			want: false,
+		},
+		{
+			name: "missing name",
+			workflow: Workflow{Statuses: []WorkflowStatus{
+				{Key: "open"},
+				{Key: "closed", Name: "Closed", Done: true},
+			}},
+			want: false,
+		},
+		{
+			name: "too many statuses",
+			workflow: func() Workflow {
+				w := Workflow{Statuses: []WorkflowStatus{{Key: "open", Name: "Open"}}}
+				for i := 0; i < MaxWorkflowStatuses; i++ {
+					w.Statuses = append(w.Statuses, WorkflowStatus{Key: "s" + strings.Repeat("x", i), Name: "S", Done: true})
+				}
+				return w
+			}(),
+			want: false,
+		},
+		{
+			name: "transition from unknown status",
+			workflow: Workflow{
+				Statuses: []WorkflowStatus{
+					{Key: "open", Name: "Open"},
+					{Key: "closed", Name: "Closed", Done: true},
+				},
+				Transitions: map[string][]string{"doing": {"closed"}},
+			},
+			want: false,
+		},
+		{
+			name: "transition to unknown status",
+			workflow: Workflow{
+				Statuses: []WorkflowStatus{
+					{Key: "open", Name: "Open"},
+					{Key: "closed", Name: "Closed", Done: true},
+				},
+				Transitions: map[string][]string{"open": {"doing"}},
+			},
+			want: false,
+		},
+		{
+			name: "transition to itself",
+			workflow: Workflow{
+				Statuses: []WorkflowStatus{
+					{Key: "open", Name: "Open"},
+					{Key: "closed", Name: "Closed", Done: true},
+				},
+				Transitions: map[string][]string{"open": {"open"}},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.workflow.IsValid(); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowAllows(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want bool
+	}{
+		{name: "forward", from: StatusBacklog, to: StatusInProgress, want: true},
+		{name: "step back", from: StatusReview, to: StatusInProgress, want: true},
+		{name: "complete from review", from: StatusReview, to: StatusDone, want: true},
+		{name: "reopen", from: StatusDone, to: StatusInProgress, want: true},
+		{name: "skip a step", from: StatusBacklog, to: StatusDone, want: false},
+		{name: "reopen to backlog", from: StatusDone, to: StatusBacklog, want: false},
+		{name: "same status", from: StatusBacklog, to: StatusBacklog, want: false},
+		{name: "unknown status", from: "blocked", to: StatusInProgress, want: false},
+	}
+
+	workflow := DefaultWorkflow()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workflow.Allows(tt.from, tt.to); got != tt.want {
+				t.Errorf("Allows(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowNext(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		done   bool
+		want   string
+		wantOK bool
+	}{
+		{name: "complete from review", from: StatusReview, done: true, want: StatusDone, wantOK: true},
+		{name: "complete from backlog", from: StatusBacklog, done: true, wantOK: false},
+		{name: "reopen", from: StatusDone, done: false, want: StatusInProgress, wantOK: true},
+		{name: "first open status in board order", from: StatusInProgress, done: false, want: StatusBacklog, wantOK: true},
+		{name: "unknown status", from: "blocked", done: true, wantOK: false},
+	}
+
+	workflow := DefaultWorkflow()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := workflow.Next(tt.from, tt.done)
+			if ok != tt.wantOK {
+				t.Fatalf("Next(%q, %v) ok = %v, want %v", tt.from, tt.done, ok, tt.wantOK)
+			}
+			if got.Key != tt.want {
+				t.Errorf("Next(%q, %v) = %q, want %q", tt.from, tt.done, got.Key, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowFit(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		completed bool
+		want      string
+	}{
+		{name: "known status", key: StatusReview, want: StatusReview},
+		{name: "removed open status", key: "blocked", want: StatusBacklog},
+		{name: "removed done status", key: "shipped", completed: true, want: StatusDone},
+	}
+
+	workflow := DefaultWorkflow()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workflow.Fit(tt.key, tt.completed); got.Key != tt.want {
+				t.Errorf("Fit(%q, %v) = %q, want %q", tt.key, tt.completed, got.Key, tt.want)
+			}
+		})
+	}
+}
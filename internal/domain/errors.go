@@ -12,6 +12,17 @@ var (
 	// ErrDatabaseOperation is returned when database operation fails
 	ErrDatabaseOperation = errors.New("database operation failed")
 
+	// ErrStorageOperation is returned when a blob storage operation fails
+	ErrStorageOperation = errors.New("storage operation failed")
+
 	// ErrDuplicateEntry is returned when trying to create a duplicate entry
 	ErrDuplicateEntry = errors.New("duplicate entry")
+
+	// ErrForbidden is returned when a user may see a resource but lacks the
+	// permission for the requested operation
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrVersionConflict is returned when a resource was modified after the
+	// version being written was read
+	ErrVersionConflict = errors.New("version conflict")
 )
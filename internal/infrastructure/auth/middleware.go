@@ -61,6 +61,22 @@ func GetUserIDFromContext(c *gin.Context) (uint, bool) {
 	return id, ok
 }
 
+// CurrentUserID extracts the authenticated user ID, responding with 401
+// when absent
+func CurrentUserID(c *gin.Context) (uint, bool) {
+	userID, ok := GetUserIDFromContext(c)
+	if !ok {
+		response.Error(c, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return 0, false
+	}
+	return userID, true
+}
+
+// ReadOnly responds with 403 when a project role does not allow changing a todo
+func ReadOnly(c *gin.Context) {
+	response.Forbidden(c, "Forbidden", "your role in the todo's project does not allow changing it")
+}
+
 // GetUserEmailFromContext extracts user email from gin context
 func GetUserEmailFromContext(c *gin.Context) (string, bool) {
 	email, exists := c.Get(ContextUserEmail)
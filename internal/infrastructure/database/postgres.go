@@ -17,6 +17,8 @@ func NewPostgresDB(cfg *configs.DatabaseConfig) (*gorm.DB, error) {
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		// Map unique violations to gorm.ErrDuplicatedKey for repositories
+		TranslateError: true,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
@@ -42,5 +44,19 @@ func AutoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&entity.Todo{},
 		&entity.User{},
+		&entity.Tag{},
+		&entity.TodoTag{},
+		&entity.ChecklistItem{},
+		&entity.TodoEvent{},
+		&entity.Project{},
+		&entity.ProjectMember{},
+		&entity.ProjectInvitation{},
+		&entity.Comment{},
+		&entity.Notification{},
+		&entity.Attachment{},
+		&entity.TodoDependency{},
+		&entity.TimeEntry{},
+		&entity.Template{},
+		&entity.Reminder{},
 	)
 }
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"gorm.io/gorm"
+)
+
+// txKey is the context key holding the active transaction
+type txKey struct{}
+
+// transactor implements contract.Transactor with GORM transactions
+type transactor struct {
+	db *gorm.DB
+}
+
+// NewTransactor creates a new Transactor instance
+func NewTransactor(db *gorm.DB) contract.Transactor {
+	return &transactor{db: db}
+}
+
+// WithinTransaction runs fn in a transaction bound to the context.
+// A nested call reuses the outer transaction through a savepoint, so the
+// inner unit of work can fail without aborting the outer one.
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return Conn(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction bound to the context, or db when no
+// transaction is active. Repositories use it instead of db.WithContext.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/arulkarim/golden-architecture/pkg/requestid"
 	"github.com/gin-gonic/gin"
 )
 
@@ -34,6 +35,7 @@ func NewServer(port int, mode string) *Server {
 	engine := gin.New()
 
 	// Add default middlewares
+	engine.Use(RequestIDMiddleware())
 	engine.Use(gin.Logger())
 	engine.Use(gin.Recovery())
 	engine.Use(CORSMiddleware())
@@ -87,7 +89,8 @@ func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, If-Match, If-None-Match, X-Request-ID")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag, X-Request-ID")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE, PATCH")
 
 		if c.Request.Method == "OPTIONS" {
@@ -98,3 +101,20 @@ func CORSMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// RequestIDMiddleware tags each request with an ID, reusing a valid
+// X-Request-ID sent by the client. The ID is echoed in the response and
+// carried by the request context.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		c.Writer.Header().Set(requestid.Header, id)
+		c.Request = c.Request.WithContext(requestid.WithID(c.Request.Context(), id))
+
+		c.Next()
+	}
+}
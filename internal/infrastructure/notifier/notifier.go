@@ -0,0 +1,29 @@
+// Package notifier provides the channels notifications are delivered
+// through outside of the application.
+package notifier
+
+import (
+	"github.com/arulkarim/golden-architecture/configs"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+)
+
+// New creates the notifiers enabled by the configuration: email when an
+// SMTP host is set and a webhook when a URL is set
+func New(cfg *configs.NotifierConfig) ([]contract.Notifier, error) {
+	var notifiers []contract.Notifier
+	if cfg.SMTP.Host != "" {
+		email, err := NewSMTPNotifier(&cfg.SMTP)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, email)
+	}
+	if cfg.Webhook.URL != "" {
+		webhook, err := NewWebhookNotifier(&cfg.Webhook)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, webhook)
+	}
+	return notifiers, nil
+}
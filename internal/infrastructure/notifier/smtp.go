@@ -0,0 +1,131 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/configs"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+)
+
+// smtpTimeout bounds a whole SMTP conversation when the context has no
+// earlier deadline
+const smtpTimeout = 30 * time.Second
+
+// SMTPNotifier implements contract.Notifier by sending plain text email
+// through a mail server
+type SMTPNotifier struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     *mail.Address
+}
+
+// NewSMTPNotifier creates a notifier sending email from the configured address
+func NewSMTPNotifier(cfg *configs.SMTPConfig) (contract.Notifier, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP from address %q", cfg.From)
+	}
+
+	return &SMTPNotifier{
+		addr:     cfg.Addr(),
+		host:     cfg.Host,
+		username: cfg.Username,
+		password: cfg.Password,
+		from:     from,
+	}, nil
+}
+
+// Channel names the email channel
+func (n *SMTPNotifier) Channel() string {
+	return "email"
+}
+
+// Notify emails the message to its recipient. The connection is upgraded
+// with STARTTLS when the server offers it; credentials are only sent over
+// an encrypted connection or to a server on localhost.
+func (n *SMTPNotifier) Notify(ctx context.Context, message contract.Message) error {
+	if message.Email == "" {
+		return errors.New("recipient has no email address")
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return fmt.Errorf("connect to mail server: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > smtpTimeout {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("greet mail server: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return fmt.Errorf("start TLS: %w", err)
+		}
+	}
+	if n.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.username, n.password, n.host)); err != nil {
+			return fmt.Errorf("authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(n.from.Address); err != nil {
+		return fmt.Errorf("set sender: %w", err)
+	}
+	if err := client.Rcpt(message.Email); err != nil {
+		return fmt.Errorf("set recipient: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("start message: %w", err)
+	}
+	if _, err := w.Write(n.compose(message, time.Now())); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+	return client.Quit()
+}
+
+// compose formats the message as a plain text email. The subject is
+// encoded as needed, which also keeps line breaks out of the headers.
+func (n *SMTPNotifier) compose(message contract.Message, now time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", n.from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", (&mail.Address{Address: message.Email}).String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+	body.Write([]byte(message.Body))
+	body.Close()
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,228 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/configs"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+)
+
+func TestCompose(t *testing.T) {
+	tests := []struct {
+		name        string
+		subject     string
+		body        string
+		wantSubject string
+	}{
+		{name: "plain", subject: "Reminder: Pay rent", body: "Pay rent.", wantSubject: "Reminder: Pay rent"},
+		{name: "non-ASCII", subject: "Reminder: Café ☕", body: "Grüße aus Köln", wantSubject: "Reminder: Café ☕"},
+		{name: "line breaks stay out of the headers", subject: "Hi\r\nBcc: eve@example.com", body: "x", wantSubject: "Hi\r\nBcc: eve@example.com"},
+		{name: "long body lines", subject: "Long", body: strings.Repeat("word ", 40), wantSubject: "Long"},
+	}
+
+	n := newTestSMTPNotifier(t, &configs.SMTPConfig{Host: "mail.example.com", From: "Todo <todo@example.com>"})
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := n.compose(contract.Message{Email: "jane@example.com", Subject: tt.subject, Body: tt.body}, now)
+
+			msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+			if err != nil {
+				t.Fatalf("ReadMessage() unexpected error: %v", err)
+			}
+			if got := msg.Header.Get("Bcc"); got != "" {
+				t.Errorf("Bcc header injected: %q", got)
+			}
+			if got := msg.Header.Get("From"); got != `"Todo" <todo@example.com>` {
+				t.Errorf("From = %q", got)
+			}
+			if got := msg.Header.Get("To"); got != "<jane@example.com>" {
+				t.Errorf("To = %q", got)
+			}
+			if got := msg.Header.Get("Date"); got != now.Format(time.RFC1123Z) {
+				t.Errorf("Date = %q", got)
+			}
+			subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+			if err != nil || subject != tt.wantSubject {
+				t.Errorf("Subject = %q, %v, want %q", subject, err, tt.wantSubject)
+			}
+
+			body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+			if err != nil {
+				t.Fatalf("decode body unexpected error: %v", err)
+			}
+			if got := strings.TrimSuffix(string(body), "\r\n"); got != tt.body {
+				t.Errorf("body = %q, want %q", got, tt.body)
+			}
+		})
+	}
+}
+
+func TestSMTPNotifierNotify(t *testing.T) {
+	tests := []struct {
+		name       string
+		username   string
+		email      string
+		rejectRcpt bool
+		wantErr    bool
+		wantAuth   string
+	}{
+		{name: "sent", email: "jane@example.com"},
+		{name: "authenticated on localhost", username: "todo", email: "jane@example.com", wantAuth: "\x00todo\x00secret"},
+		{name: "recipient rejected", email: "nobody@example.com", rejectRcpt: true, wantErr: true},
+		{name: "no address", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeSMTP(t, tt.rejectRcpt)
+			n := newTestSMTPNotifier(t, &configs.SMTPConfig{
+				Host:     "localhost",
+				Port:     server.port,
+				Username: tt.username,
+				Password: "secret",
+				From:     "todo@example.com",
+			})
+
+			err := n.Notify(context.Background(), contract.Message{Email: tt.email, Subject: "Reminder", Body: "Hello"})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Notify() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Notify() unexpected error: %v", err)
+			}
+
+			got := <-server.received
+			if got.from != "todo@example.com" || got.rcpt != tt.email {
+				t.Errorf("envelope = %s -> %s, want todo@example.com -> %s", got.from, got.rcpt, tt.email)
+			}
+			if got.auth != tt.wantAuth {
+				t.Errorf("auth = %q, want %q", got.auth, tt.wantAuth)
+			}
+			if !strings.Contains(got.data, "Subject: Reminder\r\n") {
+				t.Errorf("message lacks the subject:\n%s", got.data)
+			}
+		})
+	}
+}
+
+// newTestSMTPNotifier creates an SMTP notifier for tests
+func newTestSMTPNotifier(t *testing.T, cfg *configs.SMTPConfig) *SMTPNotifier {
+	t.Helper()
+	n, err := NewSMTPNotifier(cfg)
+	if err != nil {
+		t.Fatalf("NewSMTPNotifier() unexpected error: %v", err)
+	}
+	return n.(*SMTPNotifier)
+}
+
+// smtpSession is what a fake SMTP server received in one session
+type smtpSession struct {
+	auth string
+	from string
+	rcpt string
+	data string
+}
+
+// fakeSMTP is a single-session SMTP server on localhost without STARTTLS
+type fakeSMTP struct {
+	port     int
+	received chan smtpSession
+}
+
+// newFakeSMTP starts a fake SMTP server accepting one session
+func newFakeSMTP(t *testing.T, rejectRcpt bool) *fakeSMTP {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	server := &fakeSMTP{
+		port:     listener.Addr().(*net.TCPAddr).Port,
+		received: make(chan smtpSession, 1),
+	}
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		server.serve(conn, rejectRcpt)
+	}()
+	return server
+}
+
+// serve speaks just enough SMTP for net/smtp
+func (s *fakeSMTP) serve(conn net.Conn, rejectRcpt bool) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	var session smtpSession
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			_, initial, _ := strings.Cut(arg, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(initial)
+			session.auth = string(decoded)
+			reply("235 Authenticated")
+		case "MAIL":
+			session.from = strings.TrimSuffix(strings.TrimPrefix(arg[len("FROM:"):], "<"), ">")
+			reply("250 OK")
+		case "RCPT":
+			if rejectRcpt {
+				reply("550 No such user")
+				continue
+			}
+			session.rcpt = strings.TrimSuffix(strings.TrimPrefix(arg[len("TO:"):], "<"), ">")
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			session.data = data.String()
+			reply("250 Queued as " + strconv.Itoa(len(session.data)))
+		case "QUIT":
+			reply("221 Bye")
+			s.received <- session
+			return
+		default:
+			reply("502 Not implemented")
+		}
+	}
+}
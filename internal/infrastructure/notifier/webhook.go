@@ -0,0 +1,103 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/configs"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+)
+
+// signatureHeader carries the HMAC-SHA256 of the body, as "sha256=<hex>"
+const signatureHeader = "X-Webhook-Signature"
+
+// WebhookNotifier implements contract.Notifier by posting notifications
+// as JSON to a URL
+type WebhookNotifier struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// webhookPayload is the JSON body posted for a notification
+type webhookPayload struct {
+	Type       string `json:"type"`
+	UserID     uint   `json:"user_id"`
+	Email      string `json:"email"`
+	TodoID     uint   `json:"todo_id"`
+	CommentID  *uint  `json:"comment_id,omitempty"`
+	ReminderID *uint  `json:"reminder_id,omitempty"`
+	Subject    string `json:"subject"`
+	Body       string `json:"body"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// NewWebhookNotifier creates a notifier posting to the configured URL
+func NewWebhookNotifier(cfg *configs.WebhookConfig) (contract.Notifier, error) {
+	target, err := url.Parse(cfg.URL)
+	if err != nil || target.Host == "" || (target.Scheme != "http" && target.Scheme != "https") {
+		return nil, fmt.Errorf("invalid webhook URL %q", cfg.URL)
+	}
+
+	return &WebhookNotifier{
+		url:    target.String(),
+		secret: []byte(cfg.Secret),
+		client: &http.Client{Timeout: cfg.Timeout()},
+	}, nil
+}
+
+// Channel names the webhook channel
+func (n *WebhookNotifier) Channel() string {
+	return "webhook"
+}
+
+// Notify posts the message. Any response other than 2xx is a failure.
+func (n *WebhookNotifier) Notify(ctx context.Context, message contract.Message) error {
+	notification := message.Notification
+	body, err := json.Marshal(webhookPayload{
+		Type:       string(notification.Type),
+		UserID:     notification.UserID,
+		Email:      message.Email,
+		TodoID:     notification.TodoID,
+		CommentID:  notification.CommentID,
+		ReminderID: notification.ReminderID,
+		Subject:    message.Subject,
+		Body:       message.Body,
+		CreatedAt:  notification.CreatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(n.secret) > 0 {
+		mac := hmac.New(sha256.New, n.secret)
+		mac.Write(body)
+		req.Header.Set(signatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
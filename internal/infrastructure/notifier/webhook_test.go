@@ -0,0 +1,134 @@
+package notifier
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/configs"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func TestNewWebhookNotifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "https", url: "https://hooks.example.com/todo"},
+		{name: "http", url: "http://localhost:8081/hook"},
+		{name: "empty", url: "", wantErr: true},
+		{name: "missing host", url: "https:///hook", wantErr: true},
+		{name: "unsupported scheme", url: "ftp://hooks.example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWebhookNotifier(&configs.WebhookConfig{URL: tt.url})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewWebhookNotifier(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookNotifierNotify(t *testing.T) {
+	reminderID := uint(9)
+	message := contract.Message{
+		Notification: entity.Notification{
+			UserID:     3,
+			Type:       entity.NotificationReminder,
+			TodoID:     5,
+			ReminderID: &reminderID,
+			CreatedAt:  time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		Email:   "jane@example.com",
+		Subject: "Reminder: Pay rent",
+		Body:    "This is your reminder.",
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		status  int
+		wantErr bool
+	}{
+		{name: "signed", secret: "s3cret", status: http.StatusOK},
+		{name: "unsigned", status: http.StatusNoContent},
+		{name: "server error", status: http.StatusInternalServerError, wantErr: true},
+		{name: "not modified is no success", status: http.StatusNotModified, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			var signature, contentType string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ = io.ReadAll(r.Body)
+				signature = r.Header.Get(signatureHeader)
+				contentType = r.Header.Get("Content-Type")
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			n, err := NewWebhookNotifier(&configs.WebhookConfig{URL: server.URL, Secret: tt.secret})
+			if err != nil {
+				t.Fatalf("NewWebhookNotifier() unexpected error: %v", err)
+			}
+
+			err = n.Notify(context.Background(), message)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Notify() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Notify() unexpected error: %v", err)
+			}
+
+			if contentType != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", contentType)
+			}
+			var got webhookPayload
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("payload is not JSON: %v", err)
+			}
+			want := webhookPayload{
+				Type:       "reminder",
+				UserID:     3,
+				Email:      "jane@example.com",
+				TodoID:     5,
+				ReminderID: &reminderID,
+				Subject:    "Reminder: Pay rent",
+				Body:       "This is your reminder.",
+				CreatedAt:  "2025-01-02T03:04:05Z",
+			}
+			if got.ReminderID == nil || *got.ReminderID != reminderID {
+				t.Errorf("reminder_id = %v, want %d", got.ReminderID, reminderID)
+			}
+			got.ReminderID = want.ReminderID
+			if got != want {
+				t.Errorf("payload = %+v, want %+v", got, want)
+			}
+
+			wantSignature := ""
+			if tt.secret != "" {
+				mac := hmac.New(sha256.New, []byte(tt.secret))
+				mac.Write(body)
+				wantSignature = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+			}
+			if signature != wantSignature {
+				t.Errorf("%s = %q, want %q", signatureHeader, signature, wantSignature)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+)
+
+func TestValidKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want bool
+	}{
+		{name: "nested", key: "todos/1/report.pdf", want: true},
+		{name: "single segment", key: "report.pdf", want: true},
+		{name: "empty", key: ""},
+		{name: "absolute", key: "/todos/1"},
+		{name: "parent segment", key: "todos/../../etc/passwd"},
+		{name: "leading parent segment", key: "../todos"},
+		{name: "dot segment", key: "./todos"},
+		{name: "double slash", key: "todos//1"},
+		{name: "trailing slash", key: "todos/1/"},
+		{name: "backslash", key: `todos\1`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validKey(tt.key); got != tt.want {
+				t.Errorf("validKey(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "attachments")
+	store, err := NewLocalStore(root)
+	if err != nil {
+		t.Fatalf("NewLocalStore() unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		key     string
+		content string
+		size    int64
+		wantErr error
+	}{
+		{name: "stored", key: "todos/1/notes.txt", content: "hello", size: 5},
+		{name: "empty", key: "todos/1/empty", size: 0},
+		{name: "content longer than size is cut", key: "todos/2/cut.txt", content: "hello, world", size: 5},
+		{name: "content shorter than size", key: "todos/2/short.txt", content: "hi", size: 5, wantErr: domain.ErrStorageOperation},
+		{name: "key escaping the root", key: "../outside.txt", content: "x", size: 1, wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := store.Put(ctx, tt.key, strings.NewReader(tt.content), tt.size, "text/plain")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Put(%q) error = %v, want %v", tt.key, err, tt.wantErr)
+				}
+				if _, err := store.Open(ctx, tt.key); err == nil {
+					t.Errorf("Open(%q) found a blob after a failed Put", tt.key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Put(%q) unexpected error: %v", tt.key, err)
+			}
+
+			file, err := store.Open(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("Open(%q) unexpected error: %v", tt.key, err)
+			}
+			got, err := io.ReadAll(file)
+			file.Close()
+			if err != nil {
+				t.Fatalf("ReadAll() unexpected error: %v", err)
+			}
+			if want := tt.content[:tt.size]; string(got) != want {
+				t.Errorf("read %q, want %q", got, want)
+			}
+
+			if err := store.Delete(ctx, tt.key); err != nil {
+				t.Fatalf("Delete(%q) unexpected error: %v", tt.key, err)
+			}
+			if _, err := store.Open(ctx, tt.key); !errors.Is(err, domain.ErrNotFound) {
+				t.Errorf("Open(%q) after Delete() error = %v, want %v", tt.key, err, domain.ErrNotFound)
+			}
+			if err := store.Delete(ctx, tt.key); err != nil {
+				t.Errorf("Delete(%q) of a missing blob unexpected error: %v", tt.key, err)
+			}
+		})
+	}
+
+	entries, err := os.ReadDir(filepath.Join(root, "todos", "2"))
+	if err != nil {
+		t.Fatalf("ReadDir() unexpected error: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("temporary files left behind: %v", entries)
+	}
+}
@@ -0,0 +1,260 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/configs"
+	"github.com/arulkarim/golden-architecture/internal/domain"
+)
+
+// exampleSecret is the example secret key of the AWS documentation
+const exampleSecret = "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"
+
+func TestSign(t *testing.T) {
+	// The signatures were computed independently from the Signature
+	// Version 4 specification
+	tests := []struct {
+		name          string
+		cfg           configs.S3Config
+		method        string
+		key           string
+		payloadHash   string
+		wantURL       string
+		wantSignature string
+	}{
+		{
+			name:          "path style upload",
+			cfg:           configs.S3Config{Endpoint: "http://localhost:9000", Bucket: "attachments", PathStyle: true},
+			method:        http.MethodPut,
+			key:           "todos/1/a b.txt",
+			payloadHash:   unsignedPayload,
+			wantURL:       "http://localhost:9000/attachments/todos/1/a%20b.txt",
+			wantSignature: "70f20f7bb55753d0a77e4594665e5c57649cb7cfd59371ae2d06cdd3891b3445",
+		},
+		{
+			name:          "virtual host download",
+			cfg:           configs.S3Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Region: "eu-west-1", Bucket: "attachments"},
+			method:        http.MethodGet,
+			key:           "todos/1/report.pdf",
+			payloadHash:   emptyPayloadHash,
+			wantURL:       "https://attachments.s3.eu-west-1.amazonaws.com/todos/1/report.pdf",
+			wantSignature: "832d7d62c282829c085e161c116c33d0c08471cb60c508a0b20dae96191c9537",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.AccessKeyID = "AKIDEXAMPLE"
+			tt.cfg.SecretAccessKey = exampleSecret
+			store := newTestS3Store(t, &tt.cfg)
+
+			req, err := store.newRequest(context.Background(), tt.method, tt.key, nil)
+			if err != nil {
+				t.Fatalf("newRequest() unexpected error: %v", err)
+			}
+			if req.URL.String() != tt.wantURL {
+				t.Errorf("URL = %s, want %s", req.URL, tt.wantURL)
+			}
+
+			store.sign(req, tt.payloadHash, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+			want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20250102/" + tt.cfg.RegionOrDefault() + "/s3/aws4_request, " +
+				"SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=" + tt.wantSignature
+			if got := req.Header.Get("Authorization"); got != want {
+				t.Errorf("Authorization = %s, want %s", got, want)
+			}
+			if got := req.Header.Get("X-Amz-Date"); got != "20250102T030405Z" {
+				t.Errorf("X-Amz-Date = %s, want 20250102T030405Z", got)
+			}
+			if got := req.Header.Get("X-Amz-Content-Sha256"); got != tt.payloadHash {
+				t.Errorf("X-Amz-Content-Sha256 = %s, want %s", got, tt.payloadHash)
+			}
+		})
+	}
+}
+
+func TestNewS3Store(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         configs.S3Config
+		wantErr     bool
+		wantTimeout time.Duration
+	}{
+		{name: "default timeout", cfg: configs.S3Config{Endpoint: "http://localhost:9000", Bucket: "b"}, wantTimeout: 60 * time.Second},
+		{name: "configured timeout", cfg: configs.S3Config{Endpoint: "http://localhost:9000", Bucket: "b", TimeoutSeconds: 5}, wantTimeout: 5 * time.Second},
+		{name: "missing bucket", cfg: configs.S3Config{Endpoint: "http://localhost:9000"}, wantErr: true},
+		{name: "missing scheme", cfg: configs.S3Config{Endpoint: "localhost:9000", Bucket: "b"}, wantErr: true},
+		{name: "unsupported scheme", cfg: configs.S3Config{Endpoint: "ftp://localhost", Bucket: "b"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := NewS3Store(&tt.cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("NewS3Store() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewS3Store() unexpected error: %v", err)
+			}
+			client := store.(*S3Store).client
+			if client == http.DefaultClient || client.Timeout != tt.wantTimeout {
+				t.Errorf("client timeout = %v, want a dedicated client with %v", client.Timeout, tt.wantTimeout)
+			}
+		})
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	server := newFakeS3(t, "attachments")
+	defer server.Close()
+	store := newTestS3Store(t, &configs.S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "attachments",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: exampleSecret,
+		PathStyle:       true,
+	})
+	ctx := context.Background()
+	content := "hello, attachment"
+
+	if err := store.Put(ctx, "todos/1/a b.txt", strings.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+	if err := store.Put(ctx, "todos/1/empty", strings.NewReader(""), 0, ""); err != nil {
+		t.Fatalf("Put() of an empty blob unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		seek    int64
+		whence  int
+		want    string
+		wantErr error
+	}{
+		{name: "whole object", key: "todos/1/a b.txt", want: content},
+		{name: "from an offset", key: "todos/1/a b.txt", seek: 7, whence: io.SeekStart, want: "attachment"},
+		{name: "relative to the end", key: "todos/1/a b.txt", seek: -4, whence: io.SeekEnd, want: "ment"},
+		{name: "empty object", key: "todos/1/empty", want: ""},
+		{name: "missing object", key: "todos/1/missing", wantErr: domain.ErrNotFound},
+		{name: "invalid key", key: "../escape", wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			object, err := store.Open(ctx, tt.key)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Open(%q) error = %v, want %v", tt.key, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Open(%q) unexpected error: %v", tt.key, err)
+			}
+			defer object.Close()
+
+			if _, err := object.Seek(tt.seek, tt.whence); err != nil {
+				t.Fatalf("Seek() unexpected error: %v", err)
+			}
+			got, err := io.ReadAll(object)
+			if err != nil {
+				t.Fatalf("ReadAll() unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("read %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if err := store.Delete(ctx, "todos/1/a b.txt"); err != nil {
+		t.Fatalf("Delete() unexpected error: %v", err)
+	}
+	if err := store.Delete(ctx, "todos/1/a b.txt"); err != nil {
+		t.Fatalf("Delete() of a missing object unexpected error: %v", err)
+	}
+	if _, err := store.Open(ctx, "todos/1/a b.txt"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Open() after Delete() error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
+
+// newTestS3Store creates an S3 store for tests
+func newTestS3Store(t *testing.T, cfg *configs.S3Config) *S3Store {
+	t.Helper()
+	store, err := NewS3Store(cfg)
+	if err != nil {
+		t.Fatalf("NewS3Store() unexpected error: %v", err)
+	}
+	return store.(*S3Store)
+}
+
+// newFakeS3 serves a path-style bucket from memory. It checks that every
+// request is signed and honours open-ended ranges.
+func newFakeS3(t *testing.T, bucket string) *httptest.Server {
+	var mu sync.Mutex
+	objects := make(map[string][]byte)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+			r.Header.Get("X-Amz-Date") == "" || r.Header.Get("X-Amz-Content-Sha256") == "" {
+			t.Errorf("%s %s is not signed", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		key, ok := strings.CutPrefix(r.URL.Path, "/"+bucket+"/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		object, found := objects[key]
+		switch r.Method {
+		case http.MethodPut:
+			data, err := io.ReadAll(r.Body)
+			if err != nil || int64(len(data)) != r.ContentLength {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects[key] = data
+		case http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodHead, http.MethodGet:
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if start, ok := strings.CutPrefix(r.Header.Get("Range"), "bytes="); ok && r.Method == http.MethodGet {
+				offset, err := strconv.Atoi(strings.TrimSuffix(start, "-"))
+				if err != nil || offset >= len(object) {
+					w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+					return
+				}
+				w.Header().Set("Content-Length", strconv.Itoa(len(object)-offset))
+				w.WriteHeader(http.StatusPartialContent)
+				_, _ = io.Copy(w, bytes.NewReader(object[offset:]))
+				return
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+			if r.Method == http.MethodGet {
+				_, _ = w.Write(object)
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
@@ -0,0 +1,21 @@
+// Package storage provides the blob stores attachment contents are kept in.
+package storage
+
+import (
+	"fmt"
+
+	"github.com/arulkarim/golden-architecture/configs"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+)
+
+// New creates the blob store selected by the attachment configuration:
+// the local filesystem by default, or an S3-compatible object store
+func New(cfg *configs.AttachmentConfig) (contract.BlobStore, error) {
+	switch cfg.Storage {
+	case "", "local":
+		return NewLocalStore(cfg.Dir())
+	case "s3":
+		return NewS3Store(&cfg.S3)
+	}
+	return nil, fmt.Errorf("unknown attachment storage %q", cfg.Storage)
+}
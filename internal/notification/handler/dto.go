@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// ListNotificationsRequest represents the query parameters for listing
+// notifications. unread=true leaves out notifications already read.
+type ListNotificationsRequest struct {
+	Unread   bool `form:"unread"`
+	Page     int  `form:"page" binding:"omitempty,min=1"`
+	PageSize int  `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// NotificationResponse represents the response body for a notification.
+// actor_id is null when the acting user has been deleted; reminder_id is
+// set for reminders.
+type NotificationResponse struct {
+	ID         uint    `json:"id"`
+	Type       string  `json:"type"`
+	ActorID    *uint   `json:"actor_id"`
+	TodoID     uint    `json:"todo_id"`
+	CommentID  *uint   `json:"comment_id"`
+	ReminderID *uint   `json:"reminder_id"`
+	Read       bool    `json:"read"`
+	ReadAt     *string `json:"read_at"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+// NotificationListResponse represents the response body for a list of notifications
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Total         int64                  `json:"total"`
+	Page          int                    `json:"page"`
+	PageSize      int                    `json:"page_size"`
+	TotalPages    int                    `json:"total_pages"`
+}
+
+// MarkAllReadResponse represents the response body for marking all
+// notifications as read
+type MarkAllReadResponse struct {
+	Marked int64 `json:"marked"`
+}
+
+// NewNotificationResponse maps a notification entity to its response body
+func NewNotificationResponse(n *entity.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:         n.ID,
+		Type:       string(n.Type),
+		ActorID:    n.ActorID,
+		TodoID:     n.TodoID,
+		CommentID:  n.CommentID,
+		ReminderID: n.ReminderID,
+		Read:       n.ReadAt != nil,
+		ReadAt:     FormatOptionalTime(n.ReadAt),
+		CreatedAt:  FormatTime(n.CreatedAt),
+	}
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// FormatOptionalTime formats an optional time to RFC3339, keeping nil as nil
+func FormatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := FormatTime(*t)
+	return &formatted
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/notification"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for notifications
+type Handler struct {
+	service *notification.Service
+}
+
+// NewHandler creates a new notification handler
+func NewHandler(service *notification.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// GetAll handles GET /api/v1/notifications
+func (h *Handler) GetAll(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req ListNotificationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	result, err := h.service.List(c.Request.Context(), userID, notification.ListInput{
+		UnreadOnly: req.Unread,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		if notification.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get notifications", err.Error())
+		return
+	}
+
+	notifications := make([]NotificationResponse, 0, len(result.Notifications))
+	for i := range result.Notifications {
+		notifications = append(notifications, NewNotificationResponse(&result.Notifications[i]))
+	}
+
+	resp := NotificationListResponse{
+		Notifications: notifications,
+		Total:         result.Total,
+		Page:          result.Page,
+		PageSize:      result.PageSize,
+		TotalPages:    result.TotalPages(),
+	}
+
+	response.OK(c, "Notifications retrieved successfully", resp)
+}
+
+// MarkRead handles POST /api/v1/notifications/:id/read
+func (h *Handler) MarkRead(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "notification")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.MarkRead(c.Request.Context(), userID, id)
+	if err != nil {
+		if notification.IsNotFound(err) {
+			response.NotFound(c, "Notification not found")
+			return
+		}
+		if notification.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to mark notification as read", err.Error())
+		return
+	}
+
+	response.OK(c, "Notification marked as read", NewNotificationResponse(result))
+}
+
+// MarkAllRead handles POST /api/v1/notifications/read
+func (h *Handler) MarkAllRead(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	marked, err := h.service.MarkAllRead(c.Request.Context(), userID)
+	if err != nil {
+		if notification.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to mark notifications as read", err.Error())
+		return
+	}
+
+	response.OK(c, "Notifications marked as read", MarkAllReadResponse{Marked: marked})
+}
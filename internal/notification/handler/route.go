@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers notification routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// Notifications are only ever shown to the user they were sent to
+	notifications := router.Group("/notifications", auth.AuthMiddleware(jwtManager))
+	{
+		notifications.GET("", handler.GetAll)
+		notifications.POST("/read", handler.MarkAllRead)
+		notifications.POST("/:id/read", handler.MarkRead)
+	}
+}
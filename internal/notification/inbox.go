@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// Inbox implements contract.Notifier by keeping notifications in the
+// users' in-app inbox, where they are listed and marked as read
+type Inbox struct {
+	repo contract.NotificationRepository
+}
+
+// NewInbox creates a notifier delivering to the in-app inbox
+func NewInbox(repo contract.NotificationRepository) contract.Notifier {
+	return &Inbox{repo: repo}
+}
+
+// Channel names the inbox channel
+func (i *Inbox) Channel() string {
+	return "inbox"
+}
+
+// Notify stores the message's notification in the recipient's inbox
+func (i *Inbox) Notify(ctx context.Context, message contract.Message) error {
+	return i.repo.CreateBatch(ctx, []entity.Notification{message.Notification})
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// notificationRepository implements contract.NotificationRepository
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new NotificationRepository instance
+func NewNotificationRepository(db *gorm.DB) contract.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// ownedBy scopes a query to notifications sent to the given user
+func ownedBy(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID)
+	}
+}
+
+// unread scopes a query to unread notifications when only is set
+func unread(only bool) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if !only {
+			return db
+		}
+		return db.Where("read_at IS NULL")
+	}
+}
+
+// CreateBatch creates several notifications with a single INSERT
+func (r *notificationRepository) CreateBatch(ctx context.Context, notifications []entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	result := database.Conn(ctx, r.db).Create(&notifications)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByUser retrieves one page of the user's notifications, newest first
+func (r *notificationRepository) FindByUser(ctx context.Context, userID uint, unreadOnly bool, limit, offset int) ([]entity.Notification, int64, error) {
+	var total int64
+	result := database.Conn(ctx, r.db).
+		Model(&entity.Notification{}).
+		Scopes(ownedBy(userID), unread(unreadOnly)).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	var notifications []entity.Notification
+	result = database.Conn(ctx, r.db).
+		Scopes(ownedBy(userID), unread(unreadOnly)).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&notifications)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+	return notifications, total, nil
+}
+
+// MarkRead marks one of the user's notifications as read, keeping the
+// time it was first read
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id uint) (*entity.Notification, error) {
+	var notification entity.Notification
+	result := database.Conn(ctx, r.db).
+		Model(&notification).
+		Clauses(clause.Returning{}).
+		Scopes(ownedBy(userID)).
+		Where("id = ?", id).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", time.Now()))
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return &notification, nil
+}
+
+// MarkAllRead marks all of the user's unread notifications as read
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID uint) (int64, error) {
+	result := database.Conn(ctx, r.db).
+		Model(&entity.Notification{}).
+		Scopes(ownedBy(userID), unread(true)).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return 0, domain.ErrDatabaseOperation
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,213 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/pkg/logger"
+)
+
+const (
+	// maxRetryDelay caps the delay between delivery attempts of a reminder
+	maxRetryDelay = time.Hour
+	// deliveryLease is how long claimed reminders are kept from other
+	// schedulers while they are being sent
+	deliveryLease = 10 * time.Minute
+)
+
+// Scheduler delivers due reminders through the notifiers. Pending
+// reminders are kept in the database, so reminders that came due while the
+// API was down are delivered once it is back, and every API process can
+// run a scheduler without delivering a reminder twice, short of a crash
+// in the middle of a send.
+type Scheduler struct {
+	reminders   contract.ReminderRepository
+	tx          contract.Transactor
+	notifiers   []contract.Notifier
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	log         *logger.Logger
+}
+
+// NewScheduler creates a new reminder scheduler
+func NewScheduler(
+	reminders contract.ReminderRepository,
+	tx contract.Transactor,
+	notifiers []contract.Notifier,
+	interval time.Duration,
+	batchSize, maxAttempts int,
+	log *logger.Logger,
+) *Scheduler {
+	return &Scheduler{
+		reminders:   reminders,
+		tx:          tx,
+		notifiers:   notifiers,
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		log:         log,
+	}
+}
+
+// Run delivers due reminders immediately and then on every interval until
+// the context is cancelled. Failed runs are logged and retried on the next tick.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch delivers due reminders batch by batch until none are left and
+// logs the outcome
+func (s *Scheduler) dispatch(ctx context.Context) {
+	for {
+		claimed, sent, err := s.DeliverDue(ctx, time.Now())
+		if err != nil {
+			if ctx.Err() == nil {
+				s.log.Error("Failed to deliver reminders: %v", err)
+			}
+			return
+		}
+		if sent > 0 {
+			s.log.Info("Delivered %d reminders", sent)
+		}
+		if claimed < s.batchSize {
+			return
+		}
+	}
+}
+
+// DeliverDue claims one batch of reminders due at now and delivers each of
+// them through every notifier it has not reached yet. The claim is a short
+// transaction of its own that leases the reminders, so concurrent
+// schedulers skip them while they are sent outside any transaction. Sending
+// stops once half the lease has passed; the rest of the batch is claimed
+// again when the lease ends. It returns how many reminders were claimed and
+// how many of them were sent.
+func (s *Scheduler) DeliverDue(ctx context.Context, now time.Time) (int, int, error) {
+	var reminders []entity.Reminder
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		reminders, err = s.reminders.ClaimDue(ctx, now, deliveryLease, s.batchSize)
+		return err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var sent int
+	deadline := time.Now().Add(deliveryLease / 2)
+	for i := range reminders {
+		if ctx.Err() != nil || !time.Now().Before(deadline) {
+			break
+		}
+		delivered, err := s.deliver(ctx, &reminders[i], now)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			s.log.Error("Reminder %d was claimed again while being delivered", reminders[i].ID)
+			continue
+		}
+		if err != nil {
+			return len(reminders), sent, err
+		}
+		if delivered {
+			sent++
+		}
+	}
+
+	return len(reminders), sent, nil
+}
+
+// deliver sends a reminder through the notifiers it has not reached yet.
+// Every channel reached is recorded at once, and the outcome at the end,
+// each write committing on its own, so a crash repeats at most the send in
+// flight. Records only apply while the reminder is still at the attempt it
+// was claimed at. Failed deliveries are retried with a growing delay until
+// the attempts run out; it reports whether every notifier has the reminder.
+func (s *Scheduler) deliver(ctx context.Context, reminder *entity.Reminder, now time.Time) (bool, error) {
+	attempt := reminder.Attempts
+	message := reminderMessage(reminder, now)
+
+	var failures []string
+	for _, notifier := range s.notifiers {
+		channel := notifier.Channel()
+		if slices.Contains(reminder.Delivered, channel) {
+			continue
+		}
+		if err := notifier.Notify(ctx, message); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", channel, err))
+			continue
+		}
+		reminder.Delivered = append(reminder.Delivered, channel)
+		if err := s.reminders.UpdateDelivery(ctx, reminder, attempt); err != nil {
+			return false, err
+		}
+	}
+
+	reminder.LastError = strings.Join(failures, "; ")
+	if len(failures) > 0 {
+		if reminder.Attempts < s.maxAttempts {
+			retryAt := now.Add(retryDelay(reminder.Attempts))
+			reminder.RetryAt = &retryAt
+			return false, s.reminders.UpdateDelivery(ctx, reminder, attempt)
+		}
+		s.log.Error("Giving up on reminder %d after %d attempts: %s", reminder.ID, reminder.Attempts, reminder.LastError)
+	}
+
+	// Sent or given up on: a later fire time starts afresh
+	reminder.SentAt = &now
+	reminder.Attempts = 0
+	reminder.RetryAt = nil
+	reminder.Delivered = nil
+	return len(failures) == 0, s.reminders.UpdateDelivery(ctx, reminder, attempt)
+}
+
+// retryDelay is the delay before the next delivery attempt: a minute after
+// the first failure, doubling with every further one
+func retryDelay(attempts int) time.Duration {
+	delay := time.Minute
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// reminderMessage builds the message delivering a reminder. Reminders
+// relative to the due date mention it.
+func reminderMessage(reminder *entity.Reminder, now time.Time) contract.Message {
+	body := fmt.Sprintf("This is your reminder for %q.", reminder.TodoTitle)
+	if reminder.MinutesBefore != nil && reminder.FireAt != nil {
+		dueAt := reminder.FireAt.Add(time.Duration(*reminder.MinutesBefore) * time.Minute)
+		body = fmt.Sprintf("%q is due at %s.", reminder.TodoTitle, dueAt.UTC().Format(time.RFC1123))
+	}
+
+	reminderID := reminder.ID
+	return contract.Message{
+		Notification: entity.Notification{
+			UserID:     reminder.UserID,
+			Type:       entity.NotificationReminder,
+			TodoID:     reminder.TodoID,
+			ReminderID: &reminderID,
+			CreatedAt:  now,
+		},
+		Email:   reminder.UserEmail,
+		Subject: "Reminder: " + reminder.TodoTitle,
+		Body:    body,
+	}
+}
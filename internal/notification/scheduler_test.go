@@ -0,0 +1,201 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/pkg/logger"
+)
+
+// txKey marks contexts handed out by fakeTx
+type txKey struct{}
+
+// inTransaction reports whether ctx belongs to a fakeTx transaction
+func inTransaction(ctx context.Context) bool {
+	return ctx.Value(txKey{}) != nil
+}
+
+// fakeTx runs units of work directly, marking their context
+type fakeTx struct{}
+
+func (fakeTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// fakeReminders leases its reminders like the real repository and records
+// every delivery update
+type fakeReminders struct {
+	contract.ReminderRepository
+	t         *testing.T
+	due       []entity.Reminder
+	stored    map[uint]int
+	conflicts bool
+	updates   []entity.Reminder
+}
+
+func (f *fakeReminders) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.Reminder, error) {
+	if !inTransaction(ctx) {
+		f.t.Error("ClaimDue() called outside a transaction")
+	}
+	claimed := slices.Clone(f.due[:min(limit, len(f.due))])
+	leasedUntil := now.Add(lease)
+	for i := range claimed {
+		claimed[i].Attempts++
+		claimed[i].RetryAt = &leasedUntil
+		f.stored[claimed[i].ID] = claimed[i].Attempts
+	}
+	return claimed, nil
+}
+
+func (f *fakeReminders) UpdateDelivery(ctx context.Context, reminder *entity.Reminder, attempt int) error {
+	if inTransaction(ctx) {
+		f.t.Error("UpdateDelivery() called inside the claim transaction")
+	}
+	if f.conflicts || f.stored[reminder.ID] != attempt {
+		return domain.ErrVersionConflict
+	}
+	f.stored[reminder.ID] = reminder.Attempts
+	update := *reminder
+	update.Delivered = slices.Clone(reminder.Delivered)
+	f.updates = append(f.updates, update)
+	return nil
+}
+
+// fakeNotifier counts its deliveries, failing with err when set
+type fakeNotifier struct {
+	t       *testing.T
+	channel string
+	err     error
+	sent    int
+}
+
+func (f *fakeNotifier) Channel() string {
+	return f.channel
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, _ contract.Message) error {
+	if inTransaction(ctx) {
+		f.t.Errorf("%s notified inside a transaction", f.channel)
+	}
+	f.sent++
+	return f.err
+}
+
+func TestDeliverDue(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	retryAt := now.Add(time.Minute)
+	boom := errors.New("boom")
+
+	tests := []struct {
+		name      string
+		reminder  entity.Reminder
+		emailErr  error
+		conflicts bool
+		wantSent  int
+		wantInbox int
+		wantEmail int
+		// want is the last delivery state stored
+		want *entity.Reminder
+		// wantUpdates is the number of delivery updates stored
+		wantUpdates int
+	}{
+		{
+			name:        "delivered through every channel",
+			reminder:    entity.Reminder{ID: 1},
+			wantSent:    1,
+			wantInbox:   1,
+			wantEmail:   1,
+			want:        &entity.Reminder{ID: 1, SentAt: &now},
+			wantUpdates: 3,
+		},
+		{
+			name:        "failed channel is retried later",
+			reminder:    entity.Reminder{ID: 1},
+			emailErr:    boom,
+			wantInbox:   1,
+			wantEmail:   1,
+			want:        &entity.Reminder{ID: 1, Attempts: 1, RetryAt: &retryAt, LastError: "email: boom", Delivered: entity.TextList{"inbox"}},
+			wantUpdates: 2,
+		},
+		{
+			name:        "retry skips the channels reached",
+			reminder:    entity.Reminder{ID: 1, Attempts: 1, Delivered: entity.TextList{"inbox"}},
+			wantSent:    1,
+			wantEmail:   1,
+			want:        &entity.Reminder{ID: 1, SentAt: &now},
+			wantUpdates: 2,
+		},
+		{
+			name:        "given up on after the last attempt",
+			reminder:    entity.Reminder{ID: 1, Attempts: 2, Delivered: entity.TextList{"inbox"}},
+			emailErr:    boom,
+			wantEmail:   1,
+			want:        &entity.Reminder{ID: 1, SentAt: &now, LastError: "email: boom"},
+			wantUpdates: 1,
+		},
+		{
+			name:      "claimed again by another scheduler",
+			reminder:  entity.Reminder{ID: 1},
+			conflicts: true,
+			wantInbox: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminders := &fakeReminders{t: t, due: []entity.Reminder{tt.reminder}, stored: map[uint]int{}, conflicts: tt.conflicts}
+			inbox := &fakeNotifier{t: t, channel: "inbox"}
+			email := &fakeNotifier{t: t, channel: "email", err: tt.emailErr}
+			s := NewScheduler(reminders, fakeTx{}, []contract.Notifier{inbox, email}, time.Minute, 10, 3, logger.New())
+
+			claimed, sent, err := s.DeliverDue(context.Background(), now)
+			if err != nil {
+				t.Fatalf("DeliverDue() unexpected error: %v", err)
+			}
+			if claimed != 1 || sent != tt.wantSent {
+				t.Errorf("DeliverDue() = %d, %d, want 1, %d", claimed, sent, tt.wantSent)
+			}
+			if inbox.sent != tt.wantInbox || email.sent != tt.wantEmail {
+				t.Errorf("sent %d to the inbox and %d by email, want %d and %d", inbox.sent, email.sent, tt.wantInbox, tt.wantEmail)
+			}
+			if len(reminders.updates) != tt.wantUpdates {
+				t.Fatalf("stored %d delivery updates, want %d", len(reminders.updates), tt.wantUpdates)
+			}
+			if tt.want == nil {
+				return
+			}
+			if got := reminders.updates[len(reminders.updates)-1]; !reflect.DeepEqual(&got, tt.want) {
+				t.Errorf("stored %+v, want %+v", got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{name: "first failure", attempts: 1, want: time.Minute},
+		{name: "second failure", attempts: 2, want: 2 * time.Minute},
+		{name: "doubling", attempts: 4, want: 8 * time.Minute},
+		{name: "capped", attempts: 7, want: maxRetryDelay},
+		{name: "far past the cap", attempts: 100, want: maxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryDelay(tt.attempts); got != tt.want {
+				t.Errorf("retryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+const (
+	// DefaultPageSize is the page size used when none is requested
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page size a caller may request
+	MaxPageSize = 100
+)
+
+// Service provides notification business logic
+type Service struct {
+	repo contract.NotificationRepository
+}
+
+// NewService creates a new notification service
+func NewService(repo contract.NotificationRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// ListInput represents input for listing notifications
+type ListInput struct {
+	UnreadOnly bool
+	Page       int
+	PageSize   int
+}
+
+// Page represents one page of a user's notifications
+type Page struct {
+	Notifications []entity.Notification
+	Total         int64
+	Page          int
+	PageSize      int
+}
+
+// TotalPages returns the number of pages available for the listing
+func (p *Page) TotalPages() int {
+	if p.PageSize == 0 {
+		return 0
+	}
+	return int((p.Total + int64(p.PageSize) - 1) / int64(p.PageSize))
+}
+
+// List retrieves one page of the user's notifications, newest first
+func (s *Service) List(ctx context.Context, userID uint, input ListInput) (*Page, error) {
+	if userID == 0 || input.Page < 0 || input.PageSize < 0 || input.PageSize > MaxPageSize {
+		return nil, domain.ErrInvalidInput
+	}
+
+	page := input.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	notifications, total, err := s.repo.FindByUser(ctx, userID, input.UnreadOnly, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Page{
+		Notifications: notifications,
+		Total:         total,
+		Page:          page,
+		PageSize:      pageSize,
+	}, nil
+}
+
+// MarkRead marks one of the user's notifications as read. Notifications
+// already read keep their original read time.
+func (s *Service) MarkRead(ctx context.Context, userID, id uint) (*entity.Notification, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.repo.MarkRead(ctx, userID, id)
+}
+
+// MarkAllRead marks all of the user's unread notifications as read and
+// returns how many were marked
+func (s *Service) MarkAllRead(ctx context.Context, userID uint) (int64, error) {
+	if userID == 0 {
+		return 0, domain.ErrInvalidInput
+	}
+
+	return s.repo.MarkAllRead(ctx, userID)
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
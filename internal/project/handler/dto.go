@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// CreateProjectRequest represents the request body for creating a project.
+// Without a workflow the default one applies.
+type CreateProjectRequest struct {
+	Name         string           `json:"name" binding:"required,min=1,max=64"`
+	Color        string           `json:"color" binding:"omitempty,hexcolor"`
+	DefaultSort  string           `json:"default_sort" binding:"omitempty,oneof=created_at updated_at title due_at priority position"`
+	DefaultOrder string           `json:"default_order" binding:"omitempty,oneof=asc desc"`
+	Workflow     *WorkflowRequest `json:"workflow"`
+}
+
+// UpdateProjectRequest represents the request body for updating a project
+type UpdateProjectRequest struct {
+	Name         *string          `json:"name" binding:"omitempty,min=1,max=64"`
+	Color        *string          `json:"color" binding:"omitempty,hexcolor"`
+	Archived     *bool            `json:"archived"`
+	DefaultSort  *string          `json:"default_sort" binding:"omitempty,oneof=created_at updated_at title due_at priority position"`
+	DefaultOrder *string          `json:"default_order" binding:"omitempty,oneof=asc desc"`
+	Workflow     *WorkflowRequest `json:"workflow"`
+}
+
+// WorkflowRequest represents a project workflow: its statuses in board
+// order, the first being where new todos start, and for each status key
+// the statuses a todo may move to from it
+type WorkflowRequest struct {
+	Statuses    []WorkflowStatusRequest `json:"statuses" binding:"required,min=1,max=20,dive"`
+	Transitions map[string][]string     `json:"transitions"`
+}
+
+// WorkflowStatusRequest represents one workflow status. Todos in a done
+// status count as completed.
+type WorkflowStatusRequest struct {
+	Key  string `json:"key" binding:"required,max=32"`
+	Name string `json:"name" binding:"required,max=64"`
+	Done bool   `json:"done"`
+}
+
+// workflow maps the request to a workflow entity, or nil when it is absent
+func (r *WorkflowRequest) workflow() *entity.Workflow {
+	if r == nil {
+		return nil
+	}
+
+	workflow := &entity.Workflow{
+		Statuses:    make([]entity.WorkflowStatus, 0, len(r.Statuses)),
+		Transitions: r.Transitions,
+	}
+	for _, status := range r.Statuses {
+		workflow.Statuses = append(workflow.Statuses, entity.WorkflowStatus{
+			Key:  status.Key,
+			Name: status.Name,
+			Done: status.Done,
+		})
+	}
+	return workflow
+}
+
+// ListProjectsRequest represents the query parameters for listing projects
+type ListProjectsRequest struct {
+	Archived bool `form:"archived"`
+}
+
+// InviteRequest represents the request body for inviting a user to a project
+type InviteRequest struct {
+	Email string `json:"email" binding:"required,email,max=255"`
+	Role  string `json:"role" binding:"required,oneof=viewer editor owner"`
+}
+
+// UpdateMemberRequest represents the request body for changing a member's role
+type UpdateMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=viewer editor owner"`
+}
+
+// ProjectResponse represents the response body for a project
+type ProjectResponse struct {
+	ID           uint             `json:"id"`
+	Name         string           `json:"name"`
+	Color        string           `json:"color"`
+	Archived     bool             `json:"archived"`
+	DefaultSort  string           `json:"default_sort"`
+	DefaultOrder string           `json:"default_order"`
+	Workflow     WorkflowResponse `json:"workflow"`
+	CreatedAt    string           `json:"created_at"`
+	UpdatedAt    string           `json:"updated_at"`
+}
+
+// WorkflowResponse represents the workflow a project's todos follow
+type WorkflowResponse struct {
+	Statuses    []WorkflowStatusResponse `json:"statuses"`
+	Transitions map[string][]string      `json:"transitions"`
+}
+
+// WorkflowStatusResponse represents one workflow status
+type WorkflowStatusResponse struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+// NewWorkflowResponse maps a workflow entity to its response body
+func NewWorkflowResponse(w entity.Workflow) WorkflowResponse {
+	resp := WorkflowResponse{
+		Statuses:    make([]WorkflowStatusResponse, 0, len(w.Statuses)),
+		Transitions: w.Transitions,
+	}
+	for _, status := range w.Statuses {
+		resp.Statuses = append(resp.Statuses, WorkflowStatusResponse{
+			Key:  status.Key,
+			Name: status.Name,
+			Done: status.Done,
+		})
+	}
+	return resp
+}
+
+// NewProjectResponse maps a project entity to its response body
+func NewProjectResponse(p *entity.Project) ProjectResponse {
+	return ProjectResponse{
+		ID:           p.ID,
+		Name:         p.Name,
+		Color:        p.Color,
+		Archived:     p.Archived,
+		DefaultSort:  p.DefaultSort,
+		DefaultOrder: p.DefaultOrder,
+		Workflow:     NewWorkflowResponse(p.Workflow.OrDefault()),
+		CreatedAt:    FormatTime(p.CreatedAt),
+		UpdatedAt:    FormatTime(p.UpdatedAt),
+	}
+}
+
+// MemberResponse represents the response body for a project member
+type MemberResponse struct {
+	UserID    uint   `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+}
+
+// InvitationResponse represents the response body for a pending invitation
+type InvitationResponse struct {
+	ID        uint   `json:"id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	InvitedBy uint   `json:"invited_by"`
+	CreatedAt string `json:"created_at"`
+}
+
+// InviteResponse represents the response body for an invitation: member
+// is set when the invitee was added, invitation while they have no account
+type InviteResponse struct {
+	Status     string              `json:"status"`
+	Member     *MemberResponse     `json:"member,omitempty"`
+	Invitation *InvitationResponse `json:"invitation,omitempty"`
+}
+
+// NewMemberResponse maps a project member entity to its response body
+func NewMemberResponse(m *entity.ProjectMember) MemberResponse {
+	return MemberResponse{
+		UserID:    m.UserID,
+		Email:     m.Email,
+		Role:      string(m.Role),
+		CreatedAt: FormatTime(m.CreatedAt),
+	}
+}
+
+// NewInvitationResponse maps a project invitation entity to its response body
+func NewInvitationResponse(i *entity.ProjectInvitation) InvitationResponse {
+	return InvitationResponse{
+		ID:        i.ID,
+		Email:     i.Email,
+		Role:      string(i.Role),
+		InvitedBy: i.InvitedBy,
+		CreatedAt: FormatTime(i.CreatedAt),
+	}
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/project"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for projects
+type Handler struct {
+	service *project.Service
+}
+
+// NewHandler creates a new project handler
+func NewHandler(service *project.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create handles POST /api/v1/projects
+func (h *Handler) Create(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req CreateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	input := project.CreateProjectInput{
+		Name:         req.Name,
+		Color:        req.Color,
+		DefaultSort:  req.DefaultSort,
+		DefaultOrder: req.DefaultOrder,
+		Workflow:     req.Workflow.workflow(),
+	}
+
+	result, err := h.service.Create(c.Request.Context(), userID, input)
+	if err != nil {
+		if project.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		if project.IsDuplicate(err) {
+			response.Conflict(c, "Project already exists", "a project with this name already exists")
+			return
+		}
+		response.InternalServerError(c, "Failed to create project", err.Error())
+		return
+	}
+
+	response.Created(c, "Project created successfully", NewProjectResponse(result))
+}
+
+// GetAll handles GET /api/v1/projects
+func (h *Handler) GetAll(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req ListProjectsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	projects, err := h.service.GetAll(c.Request.Context(), userID, req.Archived)
+	if err != nil {
+		response.InternalServerError(c, "Failed to get projects", err.Error())
+		return
+	}
+
+	resp := make([]ProjectResponse, 0, len(projects))
+	for i := range projects {
+		resp = append(resp, NewProjectResponse(&projects[i]))
+	}
+
+	response.OK(c, "Projects retrieved successfully", resp)
+}
+
+// GetByID handles GET /api/v1/projects/:id
+func (h *Handler) GetByID(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.GetByID(c.Request.Context(), userID, id)
+	if err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to get project", err.Error())
+		return
+	}
+
+	response.OK(c, "Project retrieved successfully", NewProjectResponse(result))
+}
+
+// Update handles PUT /api/v1/projects/:id
+func (h *Handler) Update(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	var req UpdateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	input := project.UpdateProjectInput{
+		Name:         req.Name,
+		Color:        req.Color,
+		Archived:     req.Archived,
+		DefaultSort:  req.DefaultSort,
+		DefaultOrder: req.DefaultOrder,
+		Workflow:     req.Workflow.workflow(),
+	}
+
+	result, err := h.service.Update(c.Request.Context(), userID, id, input)
+	if err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		if project.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only project owners can update the project")
+			return
+		}
+		if project.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		if project.IsDuplicate(err) {
+			response.Conflict(c, "Project already exists", "a project with this name already exists")
+			return
+		}
+		response.InternalServerError(c, "Failed to update project", err.Error())
+		return
+	}
+
+	response.OK(c, "Project updated successfully", NewProjectResponse(result))
+}
+
+// Delete handles DELETE /api/v1/projects/:id
+func (h *Handler) Delete(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, id); err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		if project.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only project owners can delete the project")
+			return
+		}
+		response.InternalServerError(c, "Failed to delete project", err.Error())
+		return
+	}
+
+	response.OK(c, "Project deleted successfully", nil)
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/project"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ListMembers handles GET /api/v1/projects/:id/members
+func (h *Handler) ListMembers(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	members, err := h.service.Members(c.Request.Context(), userID, id)
+	if err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to get members", err.Error())
+		return
+	}
+
+	resp := make([]MemberResponse, 0, len(members))
+	for i := range members {
+		resp = append(resp, NewMemberResponse(&members[i]))
+	}
+
+	response.OK(c, "Members retrieved successfully", resp)
+}
+
+// UpdateMember handles PUT /api/v1/projects/:id/members/:userId
+func (h *Handler) UpdateMember(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+	memberID, ok := param.ID(c, "userId", "user")
+	if !ok {
+		return
+	}
+
+	var req UpdateMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	member, err := h.service.UpdateMember(c.Request.Context(), userID, id, memberID, entity.ProjectRole(req.Role))
+	if err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project or member not found")
+			return
+		}
+		if project.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only project owners can change roles")
+			return
+		}
+		if project.IsLastOwner(err) {
+			response.Conflict(c, "Cannot demote the last owner", err.Error())
+			return
+		}
+		if project.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to update member", err.Error())
+		return
+	}
+
+	response.OK(c, "Member updated successfully", NewMemberResponse(member))
+}
+
+// RemoveMember handles DELETE /api/v1/projects/:id/members/:userId
+func (h *Handler) RemoveMember(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+	memberID, ok := param.ID(c, "userId", "user")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMember(c.Request.Context(), userID, id, memberID); err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project or member not found")
+			return
+		}
+		if project.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only project owners can remove other members")
+			return
+		}
+		if project.IsLastOwner(err) {
+			response.Conflict(c, "Cannot remove the last owner", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to remove member", err.Error())
+		return
+	}
+
+	response.OK(c, "Member removed successfully", nil)
+}
+
+// Invite handles POST /api/v1/projects/:id/invitations
+func (h *Handler) Invite(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	var req InviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	input := project.InviteInput{
+		Email: req.Email,
+		Role:  entity.ProjectRole(req.Role),
+	}
+
+	result, err := h.service.Invite(c.Request.Context(), userID, id, input)
+	if err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		if project.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only project owners can invite members")
+			return
+		}
+		if project.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		if project.IsDuplicate(err) {
+			response.Conflict(c, "Already invited", "the user is already a member or invited")
+			return
+		}
+		response.InternalServerError(c, "Failed to invite member", err.Error())
+		return
+	}
+
+	if result.Member != nil {
+		member := NewMemberResponse(result.Member)
+		response.Created(c, "Member added successfully", InviteResponse{Status: "added", Member: &member})
+		return
+	}
+	invitation := NewInvitationResponse(result.Invitation)
+	response.Created(c, "Invitation created successfully", InviteResponse{Status: "invited", Invitation: &invitation})
+}
+
+// ListInvitations handles GET /api/v1/projects/:id/invitations
+func (h *Handler) ListInvitations(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	invitations, err := h.service.Invitations(c.Request.Context(), userID, id)
+	if err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		if project.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only project owners can see invitations")
+			return
+		}
+		response.InternalServerError(c, "Failed to get invitations", err.Error())
+		return
+	}
+
+	resp := make([]InvitationResponse, 0, len(invitations))
+	for i := range invitations {
+		resp = append(resp, NewInvitationResponse(&invitations[i]))
+	}
+
+	response.OK(c, "Invitations retrieved successfully", resp)
+}
+
+// CancelInvitation handles DELETE /api/v1/projects/:id/invitations/:invitationId
+func (h *Handler) CancelInvitation(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+	invitationID, ok := param.ID(c, "invitationId", "invitation")
+	if !ok {
+		return
+	}
+
+	if err := h.service.CancelInvitation(c.Request.Context(), userID, id, invitationID); err != nil {
+		if project.IsNotFound(err) {
+			response.NotFound(c, "Project or invitation not found")
+			return
+		}
+		if project.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "only project owners can cancel invitations")
+			return
+		}
+		response.InternalServerError(c, "Failed to cancel invitation", err.Error())
+		return
+	}
+
+	response.OK(c, "Invitation cancelled successfully", nil)
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers project routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// All project routes are protected; projects are scoped to the authenticated user
+	projects := router.Group("/projects", auth.AuthMiddleware(jwtManager))
+	{
+		projects.POST("", handler.Create)
+		projects.GET("", handler.GetAll)
+		projects.GET("/:id", handler.GetByID)
+		projects.PUT("/:id", handler.Update)
+		projects.DELETE("/:id", handler.Delete)
+
+		// Sharing
+		projects.GET("/:id/members", handler.ListMembers)
+		projects.PUT("/:id/members/:userId", handler.UpdateMember)
+		projects.DELETE("/:id/members/:userId", handler.RemoveMember)
+		projects.POST("/:id/invitations", handler.Invite)
+		projects.GET("/:id/invitations", handler.ListInvitations)
+		projects.DELETE("/:id/invitations/:invitationId", handler.CancelInvitation)
+	}
+}
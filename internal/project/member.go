@@ -0,0 +1,187 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// InviteInput represents input for inviting a user to a project by email
+type InviteInput struct {
+	Email string
+	Role  entity.ProjectRole
+}
+
+// InviteResult is the outcome of an invitation: invitees with an account
+// become members right away, others get a pending invitation
+type InviteResult struct {
+	Member     *entity.ProjectMember
+	Invitation *entity.ProjectInvitation
+}
+
+// Members retrieves the members of a project the given user is a member of
+func (s *Service) Members(ctx context.Context, userID, id uint) ([]entity.ProjectMember, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.repo.FindByID(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	return s.members.FindByProject(ctx, id)
+}
+
+// Invite invites a user to a project the given user owns. Registered
+// users are added as members; other addresses are invited until they
+// register.
+func (s *Service) Invite(ctx context.Context, userID, id uint, input InviteInput) (*InviteResult, error) {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	if userID == 0 || id == 0 || !input.Role.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var result InviteResult
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.authorize(ctx, userID, id, entity.RoleOwner); err != nil {
+			return err
+		}
+
+		invitee, err := s.users.FindByEmail(ctx, email)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return err
+		}
+		if invitee != nil {
+			member := &entity.ProjectMember{ProjectID: id, UserID: invitee.ID, Role: input.Role}
+			if err := s.members.Create(ctx, member); err != nil {
+				return err
+			}
+			member.Email = invitee.Email
+			result.Member = member
+			return nil
+		}
+
+		invitation := &entity.ProjectInvitation{
+			ProjectID: id,
+			Email:     email,
+			Role:      input.Role,
+			InvitedBy: userID,
+		}
+		if err := s.invitations.Create(ctx, invitation); err != nil {
+			return err
+		}
+		result.Invitation = invitation
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Invitations retrieves the pending invitations of a project the given user owns
+func (s *Service) Invitations(ctx context.Context, userID, id uint) ([]entity.ProjectInvitation, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.authorize(ctx, userID, id, entity.RoleOwner); err != nil {
+		return nil, err
+	}
+	return s.invitations.FindByProject(ctx, id)
+}
+
+// CancelInvitation deletes a pending invitation to a project the given user owns
+func (s *Service) CancelInvitation(ctx context.Context, userID, id, invitationID uint) error {
+	if userID == 0 || id == 0 || invitationID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	if _, err := s.authorize(ctx, userID, id, entity.RoleOwner); err != nil {
+		return err
+	}
+	return s.invitations.Delete(ctx, id, invitationID)
+}
+
+// UpdateMember changes the role of a member of a project the given user
+// owns. The last owner cannot be demoted.
+func (s *Service) UpdateMember(ctx context.Context, userID, id, memberID uint, role entity.ProjectRole) (*entity.ProjectMember, error) {
+	if userID == 0 || id == 0 || memberID == 0 || !role.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var member *entity.ProjectMember
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.authorize(ctx, userID, id, entity.RoleOwner); err != nil {
+			return err
+		}
+
+		var err error
+		member, err = s.members.Find(ctx, id, memberID)
+		if err != nil {
+			return err
+		}
+		if member.Role == entity.RoleOwner && role != entity.RoleOwner {
+			if err := s.keepOwner(ctx, id); err != nil {
+				return err
+			}
+		}
+
+		member.Role = role
+		return s.members.Update(ctx, member)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// RemoveMember removes a member from a project. Owners can remove anyone
+// and every member can leave; the last owner cannot.
+func (s *Service) RemoveMember(ctx context.Context, userID, id, memberID uint) error {
+	if userID == 0 || id == 0 || memberID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		required := entity.RoleOwner
+		if memberID == userID {
+			required = entity.RoleViewer
+		}
+		if _, err := s.authorize(ctx, userID, id, required); err != nil {
+			return err
+		}
+
+		member, err := s.members.Find(ctx, id, memberID)
+		if err != nil {
+			return err
+		}
+		if member.Role == entity.RoleOwner {
+			if err := s.keepOwner(ctx, id); err != nil {
+				return err
+			}
+		}
+
+		return s.members.Delete(ctx, id, memberID)
+	})
+}
+
+// keepOwner fails with ErrLastOwner unless the project has another owner
+func (s *Service) keepOwner(ctx context.Context, id uint) error {
+	owners, err := s.members.CountOwners(ctx, id)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
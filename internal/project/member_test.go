@@ -0,0 +1,236 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func (f *fakeMembers) Update(_ context.Context, member *entity.ProjectMember) error {
+	f.roles[member.UserID] = member.Role
+	return nil
+}
+
+func (f *fakeMembers) Delete(_ context.Context, _, userID uint) error {
+	delete(f.roles, userID)
+	return nil
+}
+
+func (f *fakeMembers) CountOwners(_ context.Context, _ uint) (int64, error) {
+	var owners int64
+	for _, role := range f.roles {
+		if role == entity.RoleOwner {
+			owners++
+		}
+	}
+	return owners, nil
+}
+
+// fakeUsers finds users by email ignoring case, as contract.UserRepository
+// requires
+type fakeUsers struct {
+	contract.UserRepository
+	users []entity.User
+}
+
+func (f *fakeUsers) FindByEmail(_ context.Context, email string) (*entity.User, error) {
+	for _, user := range f.users {
+		if strings.EqualFold(user.Email, email) {
+			return &user, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+// fakeInvitations records the invitations created
+type fakeInvitations struct {
+	contract.ProjectInvitationRepository
+	created []entity.ProjectInvitation
+}
+
+func (f *fakeInvitations) Create(_ context.Context, invitation *entity.ProjectInvitation) error {
+	f.created = append(f.created, *invitation)
+	return nil
+}
+
+// newMemberFixture returns the project fixture with users and invitations
+func newMemberFixture() (*Service, *fakeMembers, *fakeInvitations) {
+	s, projects, _ := newFixture()
+	invitations := &fakeInvitations{}
+	s.invitations = invitations
+	s.users = &fakeUsers{users: []entity.User{{ID: 5, Email: "Jane@Example.com"}}}
+	return s, projects.members, invitations
+}
+
+func TestInvite(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         uint
+		input          InviteInput
+		wantMember     uint
+		wantInvitation string
+		wantErr        error
+	}{
+		{
+			name:       "registered user becomes a member",
+			userID:     1,
+			input:      InviteInput{Email: " jane@example.com ", Role: entity.RoleEditor},
+			wantMember: 5,
+		},
+		{
+			name:           "unknown address is invited",
+			userID:         1,
+			input:          InviteInput{Email: "Bob@Example.com", Role: entity.RoleViewer},
+			wantInvitation: "bob@example.com",
+		},
+		{
+			name:    "editor",
+			userID:  2,
+			input:   InviteInput{Email: "bob@example.com", Role: entity.RoleViewer},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "unknown role",
+			userID:  1,
+			input:   InviteInput{Email: "bob@example.com", Role: "admin"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "invalid email",
+			userID:  1,
+			input:   InviteInput{Email: "bob", Role: entity.RoleViewer},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, members, invitations := newMemberFixture()
+
+			result, err := s.Invite(context.Background(), tt.userID, 1, tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Invite() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(members.roles) != 3 || len(invitations.created) > 0 {
+					t.Errorf("Invite() added a member or invitation on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Invite() unexpected error: %v", err)
+			}
+
+			if tt.wantMember != 0 {
+				if result.Member == nil || result.Member.UserID != tt.wantMember || members.roles[tt.wantMember] != tt.input.Role {
+					t.Errorf("member = %+v, want user %d as %s", result.Member, tt.wantMember, tt.input.Role)
+				}
+				if len(invitations.created) > 0 {
+					t.Errorf("invited %+v, want no invitation", invitations.created)
+				}
+			}
+			if tt.wantInvitation != "" {
+				if result.Invitation == nil || result.Invitation.Email != tt.wantInvitation || result.Invitation.InvitedBy != tt.userID {
+					t.Errorf("invitation = %+v, want %s invited by %d", result.Invitation, tt.wantInvitation, tt.userID)
+				}
+				if result.Member != nil {
+					t.Errorf("member = %+v, want none", result.Member)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   uint
+		memberID uint
+		role     entity.ProjectRole
+		owners   int
+		wantErr  error
+	}{
+		{name: "promote", userID: 1, memberID: 3, role: entity.RoleEditor},
+		{name: "demote an owner", userID: 1, memberID: 1, role: entity.RoleEditor, owners: 2},
+		{name: "demote the last owner", userID: 1, memberID: 1, role: entity.RoleEditor, wantErr: ErrLastOwner},
+		{name: "editor", userID: 2, memberID: 3, role: entity.RoleEditor, wantErr: domain.ErrForbidden},
+		{name: "unknown member", userID: 1, memberID: 9, role: entity.RoleEditor, wantErr: domain.ErrNotFound},
+		{name: "unknown role", userID: 1, memberID: 3, role: "admin", wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, members, _ := newMemberFixture()
+			if tt.owners > 1 {
+				members.roles[6] = entity.RoleOwner
+			}
+			before := members.roles[tt.memberID]
+
+			member, err := s.UpdateMember(context.Background(), tt.userID, 1, tt.memberID, tt.role)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("UpdateMember() error = %v, want %v", err, tt.wantErr)
+				}
+				if members.roles[tt.memberID] != before {
+					t.Errorf("UpdateMember() changed the role to %q on error", members.roles[tt.memberID])
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateMember() unexpected error: %v", err)
+			}
+			if member.Role != tt.role || members.roles[tt.memberID] != tt.role {
+				t.Errorf("role = %q, want %q", members.roles[tt.memberID], tt.role)
+			}
+		})
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   uint
+		memberID uint
+		owners   int
+		wantErr  error
+	}{
+		{name: "owner removes a member", userID: 1, memberID: 2},
+		{name: "viewer leaves", userID: 3, memberID: 3},
+		{name: "owner leaves with another owner", userID: 1, memberID: 1, owners: 2},
+		{name: "last owner leaves", userID: 1, memberID: 1, wantErr: ErrLastOwner},
+		{name: "editor removes a member", userID: 2, memberID: 3, wantErr: domain.ErrForbidden},
+		{name: "not a member", userID: 4, memberID: 4, wantErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, members, _ := newMemberFixture()
+			if tt.owners > 1 {
+				members.roles[6] = entity.RoleOwner
+			}
+			count := len(members.roles)
+
+			err := s.RemoveMember(context.Background(), tt.userID, 1, tt.memberID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RemoveMember() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(members.roles) != count {
+					t.Errorf("RemoveMember() removed a member on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RemoveMember() unexpected error: %v", err)
+			}
+			if _, ok := members.roles[tt.memberID]; ok {
+				t.Errorf("member %d is still in the project", tt.memberID)
+			}
+		})
+	}
+}
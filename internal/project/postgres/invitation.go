@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// invitationRepository implements contract.ProjectInvitationRepository
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+// NewProjectInvitationRepository creates a new ProjectInvitationRepository instance
+func NewProjectInvitationRepository(db *gorm.DB) contract.ProjectInvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+// Create stores an invitation
+func (r *invitationRepository) Create(ctx context.Context, invitation *entity.ProjectInvitation) error {
+	result := database.Conn(ctx, r.db).Create(invitation)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByProject retrieves the pending invitations of a project
+func (r *invitationRepository) FindByProject(ctx context.Context, projectID uint) ([]entity.ProjectInvitation, error) {
+	var invitations []entity.ProjectInvitation
+	result := database.Conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("id ASC").
+		Find(&invitations)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return invitations, nil
+}
+
+// Delete deletes an invitation to a project by its ID
+func (r *invitationRepository) Delete(ctx context.Context, projectID, id uint) error {
+	result := database.Conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Delete(&entity.ProjectInvitation{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Accept turns the pending invitations for an email into memberships with
+// one INSERT ... SELECT, keeping existing memberships as they are
+func (r *invitationRepository) Accept(ctx context.Context, email string, userID uint) error {
+	db := database.Conn(ctx, r.db)
+
+	result := db.Exec(
+		"INSERT INTO project_members (project_id, user_id, role, created_at, updated_at) "+
+			"SELECT project_id, ?, role, NOW(), NOW() FROM project_invitations WHERE email = ? "+
+			"ON CONFLICT (project_id, user_id) DO NOTHING",
+		userID, email,
+	)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+
+	result = db.Where("email = ?", email).Delete(&entity.ProjectInvitation{})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
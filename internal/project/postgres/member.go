@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// memberRepository implements contract.ProjectMemberRepository
+type memberRepository struct {
+	db *gorm.DB
+}
+
+// NewProjectMemberRepository creates a new ProjectMemberRepository instance
+func NewProjectMemberRepository(db *gorm.DB) contract.ProjectMemberRepository {
+	return &memberRepository{db: db}
+}
+
+// withEmail selects members together with their user's email
+func withEmail(db *gorm.DB) *gorm.DB {
+	return db.
+		Select("project_members.*, users.email").
+		Joins("JOIN users ON users.id = project_members.user_id")
+}
+
+// Create adds a member to a project
+func (r *memberRepository) Create(ctx context.Context, member *entity.ProjectMember) error {
+	result := database.Conn(ctx, r.db).Create(member)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// Find finds the membership of a user in a project
+func (r *memberRepository) Find(ctx context.Context, projectID, userID uint) (*entity.ProjectMember, error) {
+	var member entity.ProjectMember
+	result := database.Conn(ctx, r.db).
+		Scopes(withEmail).
+		Where("project_members.project_id = ? AND project_members.user_id = ?", projectID, userID).
+		First(&member)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &member, nil
+}
+
+// FindByProject retrieves the members of a project
+func (r *memberRepository) FindByProject(ctx context.Context, projectID uint) ([]entity.ProjectMember, error) {
+	var members []entity.ProjectMember
+	result := database.Conn(ctx, r.db).
+		Scopes(withEmail).
+		Where("project_members.project_id = ?", projectID).
+		Order("project_members.created_at ASC, project_members.user_id ASC").
+		Find(&members)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return members, nil
+}
+
+// Update changes the role of a member
+func (r *memberRepository) Update(ctx context.Context, member *entity.ProjectMember) error {
+	result := database.Conn(ctx, r.db).
+		Model(&entity.ProjectMember{}).
+		Where("project_id = ? AND user_id = ?", member.ProjectID, member.UserID).
+		Updates(map[string]interface{}{"role": member.Role})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a member from a project
+func (r *memberRepository) Delete(ctx context.Context, projectID, userID uint) error {
+	result := database.Conn(ctx, r.db).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Delete(&entity.ProjectMember{})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// CountOwners counts the owners of a project
+func (r *memberRepository) CountOwners(ctx context.Context, projectID uint) (int64, error) {
+	var count int64
+	result := database.Conn(ctx, r.db).
+		Model(&entity.ProjectMember{}).
+		Where("project_id = ? AND role = ?", projectID, entity.RoleOwner).
+		Count(&count)
+	if result.Error != nil {
+		return 0, domain.ErrDatabaseOperation
+	}
+	return count, nil
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// projectRepository implements contract.ProjectRepository
+type projectRepository struct {
+	db *gorm.DB
+}
+
+// NewProjectRepository creates a new ProjectRepository instance
+func NewProjectRepository(db *gorm.DB) contract.ProjectRepository {
+	return &projectRepository{db: db}
+}
+
+// ownedBy scopes a query to projects created by the given user
+func ownedBy(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID)
+	}
+}
+
+// memberOf scopes a query to projects the given user is a member of
+func memberOf(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("id IN (SELECT project_id FROM project_members WHERE user_id = ?)", userID)
+	}
+}
+
+// Create creates a new project
+func (r *projectRepository) Create(ctx context.Context, project *entity.Project) error {
+	result := database.Conn(ctx, r.db).Create(project)
+	if result.Error != nil {
+		// Check for duplicate name
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds a project by its ID
+func (r *projectRepository) FindByID(ctx context.Context, userID, id uint) (*entity.Project, error) {
+	var project entity.Project
+	result := database.Conn(ctx, r.db).Scopes(memberOf(userID)).First(&project, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &project, nil
+}
+
+// FindAll retrieves all projects
+func (r *projectRepository) FindAll(ctx context.Context, userID uint, includeArchived bool) ([]entity.Project, error) {
+	var projects []entity.Project
+	query := database.Conn(ctx, r.db).Scopes(memberOf(userID))
+	if !includeArchived {
+		query = query.Where("archived = ?", false)
+	}
+	result := query.Order("name ASC").Find(&projects)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return projects, nil
+}
+
+// Update updates an existing project
+func (r *projectRepository) Update(ctx context.Context, project *entity.Project) error {
+	result := database.Conn(ctx, r.db).
+		Model(project).
+		Scopes(ownedBy(project.UserID)).
+		Select("*").
+		Omit("id", "user_id", "created_at").
+		Updates(project)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// FindWorkflow returns the workflow of a project by its ID, regardless of
+// membership, with the default workflow standing in for an empty one
+func (r *projectRepository) FindWorkflow(ctx context.Context, id uint) (entity.Workflow, error) {
+	var project entity.Project
+	result := database.Conn(ctx, r.db).Select("id", "workflow").First(&project, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entity.Workflow{}, domain.ErrNotFound
+		}
+		return entity.Workflow{}, domain.ErrDatabaseOperation
+	}
+	return project.Workflow.OrDefault(), nil
+}
+
+// FitStatuses moves the project's todos, trashed ones included, whose
+// status the workflow lacks to its done or initial status, then re-derives
+// the completed flag of those whose status changed meaning. Changed todos
+// advance their version.
+func (r *projectRepository) FitStatuses(ctx context.Context, id uint, workflow entity.Workflow) error {
+	db := database.Conn(ctx, r.db)
+	doneKeys := workflow.DoneKeys()
+
+	result := db.Model(&entity.Todo{}).
+		Unscoped().
+		Where("project_id = ? AND status NOT IN ?", id, workflow.Keys()).
+		Updates(map[string]interface{}{
+			"status":  gorm.Expr("CASE WHEN completed THEN ? ELSE ? END", workflow.DoneStatus().Key, workflow.Initial().Key),
+			"version": gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+
+	result = db.Model(&entity.Todo{}).
+		Unscoped().
+		Where("project_id = ? AND completed <> (status IN ?)", id, doneKeys).
+		Updates(map[string]interface{}{
+			"completed":    gorm.Expr("status IN ?", doneKeys),
+			"completed_at": gorm.Expr("CASE WHEN status IN ? THEN NOW() END", doneKeys),
+			"version":      gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// Delete deletes a project by its ID
+func (r *projectRepository) Delete(ctx context.Context, id uint) error {
+	result := database.Conn(ctx, r.db).Delete(&entity.Project{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// sortFields lists the todo sort fields a project may default to
+var sortFields = []string{
+	contract.TodoSortCreatedAt,
+	contract.TodoSortUpdatedAt,
+	contract.TodoSortTitle,
+	contract.TodoSortDueAt,
+	contract.TodoSortPriority,
+	contract.TodoSortPosition,
+}
+
+// ErrLastOwner is returned when a change would leave a project without an owner
+var ErrLastOwner = errors.New("a project needs at least one owner")
+
+// Service provides project business logic
+type Service struct {
+	repo        contract.ProjectRepository
+	members     contract.ProjectMemberRepository
+	invitations contract.ProjectInvitationRepository
+	users       contract.UserRepository
+	todos       contract.TodoRepository
+	tx          contract.Transactor
+}
+
+// NewService creates a new project service
+func NewService(
+	repo contract.ProjectRepository,
+	members contract.ProjectMemberRepository,
+	invitations contract.ProjectInvitationRepository,
+	users contract.UserRepository,
+	todos contract.TodoRepository,
+	tx contract.Transactor,
+) *Service {
+	return &Service{
+		repo:        repo,
+		members:     members,
+		invitations: invitations,
+		users:       users,
+		todos:       todos,
+		tx:          tx,
+	}
+}
+
+// CreateProjectInput represents input for creating a project.
+// DefaultSort and DefaultOrder default to newest first when empty; a
+// position sort defaults to ascending. Without a Workflow the default
+// workflow applies.
+type CreateProjectInput struct {
+	Name         string
+	Color        string
+	DefaultSort  string
+	DefaultOrder string
+	Workflow     *entity.Workflow
+}
+
+// UpdateProjectInput represents input for updating a project.
+// A new Workflow moves todos in statuses it lacks to its done or initial
+// status.
+type UpdateProjectInput struct {
+	Name         *string
+	Color        *string
+	Archived     *bool
+	DefaultSort  *string
+	DefaultOrder *string
+	Workflow     *entity.Workflow
+}
+
+// Create creates a new project owned by the given user, who becomes its
+// first owner member
+func (s *Service) Create(ctx context.Context, userID uint, input CreateProjectInput) (*entity.Project, error) {
+	name := strings.TrimSpace(input.Name)
+	if userID == 0 || name == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	project := &entity.Project{
+		UserID:       userID,
+		Name:         name,
+		Color:        input.Color,
+		DefaultSort:  input.DefaultSort,
+		DefaultOrder: input.DefaultOrder,
+	}
+	if project.DefaultSort == "" {
+		project.DefaultSort = contract.TodoSortCreatedAt
+	}
+	if project.DefaultOrder == "" {
+		project.DefaultOrder = contract.SortDesc
+		if project.DefaultSort == contract.TodoSortPosition {
+			project.DefaultOrder = contract.SortAsc
+		}
+	}
+	if !validSort(project.DefaultSort, project.DefaultOrder) {
+		return nil, domain.ErrInvalidInput
+	}
+	if input.Workflow != nil {
+		if !input.Workflow.IsValid() {
+			return nil, domain.ErrInvalidInput
+		}
+		project.Workflow = *input.Workflow
+	}
+
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, project); err != nil {
+			return err
+		}
+		return s.members.Create(ctx, &entity.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    userID,
+			Role:      entity.RoleOwner,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// GetByID retrieves a project by ID the given user is a member of
+func (s *Service) GetByID(ctx context.Context, userID, id uint) (*entity.Project, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.repo.FindByID(ctx, userID, id)
+}
+
+// GetAll retrieves the projects the given user is a member of, leaving out
+// archived ones unless includeArchived is set
+func (s *Service) GetAll(ctx context.Context, userID uint, includeArchived bool) ([]entity.Project, error) {
+	if userID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.repo.FindAll(ctx, userID, includeArchived)
+}
+
+// Update updates an existing project the given user owns
+func (s *Service) Update(ctx context.Context, userID, id uint, input UpdateProjectInput) (*entity.Project, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	project, err := s.authorize(ctx, userID, id, entity.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields if provided
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return nil, domain.ErrInvalidInput
+		}
+		project.Name = name
+	}
+	if input.Color != nil {
+		project.Color = *input.Color
+	}
+	if input.Archived != nil {
+		project.Archived = *input.Archived
+	}
+	if input.DefaultSort != nil {
+		project.DefaultSort = *input.DefaultSort
+	}
+	if input.DefaultOrder != nil {
+		project.DefaultOrder = *input.DefaultOrder
+	}
+	if !validSort(project.DefaultSort, project.DefaultOrder) {
+		return nil, domain.ErrInvalidInput
+	}
+	if input.Workflow != nil {
+		if !input.Workflow.IsValid() {
+			return nil, domain.ErrInvalidInput
+		}
+		project.Workflow = *input.Workflow
+	}
+
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, project); err != nil {
+			return err
+		}
+		if input.Workflow == nil {
+			return nil
+		}
+		return s.repo.FitStatuses(ctx, project.ID, project.Workflow)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// Delete deletes a project by ID the given user owns.
+// Its todos, trashed ones included, take statuses of the default workflow
+// and are moved to the end of their owners' inboxes.
+func (s *Service) Delete(ctx context.Context, userID, id uint) error {
+	if userID == 0 || id == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.authorize(ctx, userID, id, entity.RoleOwner); err != nil {
+			return err
+		}
+		if err := s.repo.FitStatuses(ctx, id, entity.DefaultWorkflow()); err != nil {
+			return err
+		}
+		if err := s.todos.MoveToInbox(ctx, id); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, id)
+	})
+}
+
+// authorize finds a project the user is a member of and checks that their
+// role grants at least the required permissions
+func (s *Service) authorize(ctx context.Context, userID, id uint, required entity.ProjectRole) (*entity.Project, error) {
+	project, err := s.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.members.Find(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Role.Allows(required) {
+		return nil, domain.ErrForbidden
+	}
+
+	return project, nil
+}
+
+// validSort reports whether a default sort field and direction are supported
+func validSort(sortBy, sortOrder string) bool {
+	return slices.Contains(sortFields, sortBy) &&
+		(sortOrder == contract.SortAsc || sortOrder == contract.SortDesc)
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
+
+// IsForbidden checks if error is caused by a role lacking a permission
+func IsForbidden(err error) bool {
+	return errors.Is(err, domain.ErrForbidden)
+}
+
+// IsLastOwner checks if error is caused by removing the last owner
+func IsLastOwner(err error) bool {
+	return errors.Is(err, ErrLastOwner)
+}
+
+// IsDuplicate checks if error is a duplicate project name, member or
+// invitation error
+func IsDuplicate(err error) bool {
+	return errors.Is(err, domain.ErrDuplicateEntry)
+}
@@ -0,0 +1,318 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// fakeTx runs every function directly, as if its transaction committed
+type fakeTx struct{}
+
+func (fakeTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeProjects holds one project visible to every member and records the
+// changes made to it
+type fakeProjects struct {
+	contract.ProjectRepository
+	project *entity.Project
+	members *fakeMembers
+	fitted  []entity.Workflow
+	deleted bool
+}
+
+func (f *fakeProjects) Create(_ context.Context, project *entity.Project) error {
+	project.ID = 1
+	f.project = project
+	return nil
+}
+
+func (f *fakeProjects) FindByID(_ context.Context, userID, id uint) (*entity.Project, error) {
+	if f.project == nil || f.project.ID != id {
+		return nil, domain.ErrNotFound
+	}
+	if _, ok := f.members.roles[userID]; !ok {
+		return nil, domain.ErrNotFound
+	}
+	project := *f.project
+	return &project, nil
+}
+
+func (f *fakeProjects) Update(_ context.Context, project *entity.Project) error {
+	*f.project = *project
+	return nil
+}
+
+func (f *fakeProjects) FitStatuses(_ context.Context, _ uint, workflow entity.Workflow) error {
+	f.fitted = append(f.fitted, workflow)
+	return nil
+}
+
+func (f *fakeProjects) Delete(_ context.Context, _ uint) error {
+	f.deleted = true
+	return nil
+}
+
+// fakeMembers holds the roles of the members of the project, keyed by user ID
+type fakeMembers struct {
+	contract.ProjectMemberRepository
+	roles map[uint]entity.ProjectRole
+}
+
+func (f *fakeMembers) Create(_ context.Context, member *entity.ProjectMember) error {
+	f.roles[member.UserID] = member.Role
+	return nil
+}
+
+func (f *fakeMembers) Find(_ context.Context, projectID, userID uint) (*entity.ProjectMember, error) {
+	role, ok := f.roles[userID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &entity.ProjectMember{ProjectID: projectID, UserID: userID, Role: role}, nil
+}
+
+// fakeTodos records the projects whose todos were moved to the inbox
+type fakeTodos struct {
+	contract.TodoRepository
+	moved []uint
+}
+
+func (f *fakeTodos) MoveToInbox(_ context.Context, projectID uint) error {
+	f.moved = append(f.moved, projectID)
+	return nil
+}
+
+// newFixture returns a service for a project owned by user 1, edited by
+// user 2 and viewed by user 3
+func newFixture() (*Service, *fakeProjects, *fakeTodos) {
+	members := &fakeMembers{roles: map[uint]entity.ProjectRole{
+		1: entity.RoleOwner,
+		2: entity.RoleEditor,
+		3: entity.RoleViewer,
+	}}
+	projects := &fakeProjects{
+		project: &entity.Project{
+			ID:           1,
+			UserID:       1,
+			Name:         "Home",
+			DefaultSort:  contract.TodoSortCreatedAt,
+			DefaultOrder: contract.SortDesc,
+		},
+		members: members,
+	}
+	todos := &fakeTodos{}
+	return NewService(projects, members, nil, nil, todos, fakeTx{}), projects, todos
+}
+
+// customWorkflow is a valid workflow other than the default one
+func customWorkflow() *entity.Workflow {
+	return &entity.Workflow{Statuses: []entity.WorkflowStatus{
+		{Key: "open", Name: "Open"},
+		{Key: "closed", Name: "Closed", Done: true},
+	}}
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     CreateProjectInput
+		wantSort  string
+		wantOrder string
+		wantErr   bool
+	}{
+		{
+			name:      "defaults to newest first",
+			input:     CreateProjectInput{Name: " Home "},
+			wantSort:  contract.TodoSortCreatedAt,
+			wantOrder: contract.SortDesc,
+		},
+		{
+			name:      "position defaults to ascending",
+			input:     CreateProjectInput{Name: "Home", DefaultSort: contract.TodoSortPosition},
+			wantSort:  contract.TodoSortPosition,
+			wantOrder: contract.SortAsc,
+		},
+		{
+			name:      "explicit sort",
+			input:     CreateProjectInput{Name: "Home", DefaultSort: contract.TodoSortDueAt, DefaultOrder: contract.SortAsc},
+			wantSort:  contract.TodoSortDueAt,
+			wantOrder: contract.SortAsc,
+		},
+		{
+			name:      "custom workflow",
+			input:     CreateProjectInput{Name: "Home", Workflow: customWorkflow()},
+			wantSort:  contract.TodoSortCreatedAt,
+			wantOrder: contract.SortDesc,
+		},
+		{name: "blank name", input: CreateProjectInput{Name: "  "}, wantErr: true},
+		{name: "unknown sort field", input: CreateProjectInput{Name: "Home", DefaultSort: "completed"}, wantErr: true},
+		{name: "unknown sort order", input: CreateProjectInput{Name: "Home", DefaultOrder: "up"}, wantErr: true},
+		{name: "invalid workflow", input: CreateProjectInput{Name: "Home", Workflow: &entity.Workflow{}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := &fakeMembers{roles: map[uint]entity.ProjectRole{}}
+			s := NewService(&fakeProjects{members: members}, members, nil, nil, nil, fakeTx{})
+
+			project, err := s.Create(context.Background(), 1, tt.input)
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("Create() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Create() unexpected error: %v", err)
+			}
+
+			if project.Name != "Home" || project.DefaultSort != tt.wantSort || project.DefaultOrder != tt.wantOrder {
+				t.Errorf("project = %q sorted by %s %s, want %q sorted by %s %s",
+					project.Name, project.DefaultSort, project.DefaultOrder, "Home", tt.wantSort, tt.wantOrder)
+			}
+			if tt.input.Workflow != nil && len(project.Workflow.Statuses) != len(tt.input.Workflow.Statuses) {
+				t.Errorf("workflow = %+v, want %+v", project.Workflow, *tt.input.Workflow)
+			}
+			if members.roles[1] != entity.RoleOwner {
+				t.Errorf("creator role = %q, want %q", members.roles[1], entity.RoleOwner)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	text := func(s string) *string { return &s }
+
+	tests := []struct {
+		name       string
+		userID     uint
+		input      UpdateProjectInput
+		wantSort   string
+		wantOrder  string
+		wantFitted bool
+		wantErr    error
+	}{
+		{
+			name:      "sort",
+			userID:    1,
+			input:     UpdateProjectInput{DefaultSort: text(contract.TodoSortPriority), DefaultOrder: text(contract.SortAsc)},
+			wantSort:  contract.TodoSortPriority,
+			wantOrder: contract.SortAsc,
+		},
+		{
+			name:       "workflow fits the todos' statuses",
+			userID:     1,
+			input:      UpdateProjectInput{Workflow: customWorkflow()},
+			wantSort:   contract.TodoSortCreatedAt,
+			wantOrder:  contract.SortDesc,
+			wantFitted: true,
+		},
+		{
+			name:    "unknown sort field",
+			userID:  1,
+			input:   UpdateProjectInput{DefaultSort: text("completed")},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "blank name",
+			userID:  1,
+			input:   UpdateProjectInput{Name: text(" ")},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "invalid workflow",
+			userID:  1,
+			input:   UpdateProjectInput{Workflow: &entity.Workflow{}},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "editor",
+			userID:  2,
+			input:   UpdateProjectInput{DefaultSort: text(contract.TodoSortTitle)},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "not a member",
+			userID:  4,
+			input:   UpdateProjectInput{DefaultSort: text(contract.TodoSortTitle)},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, projects, _ := newFixture()
+
+			project, err := s.Update(context.Background(), tt.userID, 1, tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+				}
+				if projects.project.DefaultSort != contract.TodoSortCreatedAt || len(projects.fitted) > 0 {
+					t.Errorf("Update() changed the project on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() unexpected error: %v", err)
+			}
+
+			if project.DefaultSort != tt.wantSort || project.DefaultOrder != tt.wantOrder {
+				t.Errorf("sort = %s %s, want %s %s", project.DefaultSort, project.DefaultOrder, tt.wantSort, tt.wantOrder)
+			}
+			if projects.project.DefaultSort != tt.wantSort {
+				t.Errorf("stored sort = %s, want %s", projects.project.DefaultSort, tt.wantSort)
+			}
+			if (len(projects.fitted) > 0) != tt.wantFitted {
+				t.Errorf("fitted statuses to %v, want fitted %v", projects.fitted, tt.wantFitted)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  uint
+		wantErr error
+	}{
+		{name: "owner", userID: 1},
+		{name: "editor", userID: 2, wantErr: domain.ErrForbidden},
+		{name: "viewer", userID: 3, wantErr: domain.ErrForbidden},
+		{name: "not a member", userID: 4, wantErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, projects, todos := newFixture()
+
+			err := s.Delete(context.Background(), tt.userID, 1)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Delete() error = %v, want %v", err, tt.wantErr)
+				}
+				if projects.deleted || len(todos.moved) > 0 {
+					t.Errorf("Delete() changed the project on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Delete() unexpected error: %v", err)
+			}
+
+			if !projects.deleted || len(todos.moved) != 1 || todos.moved[0] != 1 {
+				t.Errorf("deleted = %v and moved %v to the inbox, want deleted and [1]", projects.deleted, todos.moved)
+			}
+			if len(projects.fitted) != 1 || len(projects.fitted[0].Statuses) != len(entity.DefaultWorkflow().Statuses) {
+				t.Errorf("fitted statuses to %v, want the default workflow", projects.fitted)
+			}
+		})
+	}
+}
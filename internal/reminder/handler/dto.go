@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// CreateReminderRequest represents the request body for setting a
+// reminder: either at, an RFC3339 time in the future, or
+// minutes_before_due, relative to the todo's due date
+type CreateReminderRequest struct {
+	At               *time.Time `json:"at"`
+	MinutesBeforeDue *int       `json:"minutes_before_due" binding:"omitempty,min=0,max=525600"`
+}
+
+// ReminderResponse represents the response body for a reminder. fire_at is
+// null for a relative reminder while the todo has no due date; status is
+// pending, sent or failed, and last_error tells why delivery failed.
+type ReminderResponse struct {
+	ID               uint    `json:"id"`
+	TodoID           uint    `json:"todo_id"`
+	At               *string `json:"at"`
+	MinutesBeforeDue *int    `json:"minutes_before_due"`
+	FireAt           *string `json:"fire_at"`
+	Status           string  `json:"status"`
+	SentAt           *string `json:"sent_at"`
+	Attempts         int     `json:"attempts"`
+	LastError        string  `json:"last_error"`
+	CreatedAt        string  `json:"created_at"`
+}
+
+// NewReminderResponse maps a reminder entity to its response body
+func NewReminderResponse(r *entity.Reminder) ReminderResponse {
+	status := "pending"
+	switch {
+	case r.Failed():
+		status = "failed"
+	case !r.Pending():
+		status = "sent"
+	}
+
+	return ReminderResponse{
+		ID:               r.ID,
+		TodoID:           r.TodoID,
+		At:               FormatOptionalTime(r.RemindAt),
+		MinutesBeforeDue: r.MinutesBefore,
+		FireAt:           FormatOptionalTime(r.FireAt),
+		Status:           status,
+		SentAt:           FormatOptionalTime(r.SentAt),
+		Attempts:         r.Attempts,
+		LastError:        r.LastError,
+		CreatedAt:        FormatTime(r.CreatedAt),
+	}
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// FormatOptionalTime formats an optional time to RFC3339, keeping nil as nil
+func FormatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := FormatTime(*t)
+	return &formatted
+}
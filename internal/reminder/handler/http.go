@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/reminder"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for reminders on todos
+type Handler struct {
+	service *reminder.Service
+}
+
+// NewHandler creates a new reminder handler
+func NewHandler(service *reminder.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// List handles GET /api/v1/todos/:id/reminders.
+// Lists the authenticated user's own reminders on the todo.
+func (h *Handler) List(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	reminders, err := h.service.List(c.Request.Context(), userID, todoID)
+	if err != nil {
+		if reminder.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to get reminders", err.Error())
+		return
+	}
+
+	resp := make([]ReminderResponse, 0, len(reminders))
+	for i := range reminders {
+		resp = append(resp, NewReminderResponse(&reminders[i]))
+	}
+
+	response.OK(c, "Reminders retrieved successfully", resp)
+}
+
+// Create handles POST /api/v1/todos/:id/reminders
+func (h *Handler) Create(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req CreateReminderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	created, err := h.service.Add(c.Request.Context(), userID, todoID, reminder.AddInput{
+		At:            req.At,
+		MinutesBefore: req.MinutesBeforeDue,
+	})
+	if err != nil {
+		switch {
+		case reminder.IsNotFound(err):
+			response.NotFound(c, "Todo not found")
+		case reminder.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to create reminder", err.Error())
+		}
+		return
+	}
+
+	response.Created(c, "Reminder created successfully", NewReminderResponse(created))
+}
+
+// Delete handles DELETE /api/v1/todos/:id/reminders/:reminderId
+func (h *Handler) Delete(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	reminderID, ok := param.ID(c, "reminderId", "reminder")
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, todoID, reminderID); err != nil {
+		switch {
+		case reminder.IsNotFound(err):
+			response.NotFound(c, "Reminder not found")
+		case reminder.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to delete reminder", err.Error())
+		}
+		return
+	}
+
+	response.OK(c, "Reminder deleted successfully", nil)
+}
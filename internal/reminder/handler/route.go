@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers reminder routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// Reminders are personal; each user only sees their own on a visible todo
+	todos := router.Group("/todos", auth.AuthMiddleware(jwtManager))
+	{
+		todos.GET("/:id/reminders", handler.List)
+		todos.POST("/:id/reminders", handler.Create)
+		todos.DELETE("/:id/reminders/:reminderId", handler.Delete)
+	}
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reminderFireAt is when a reminder is due: its fixed time, or its offset
+// before the due date of its todo (NULL while the todo has none)
+const reminderFireAt = "COALESCE(reminders.remind_at, todos.due_at - reminders.minutes_before * INTERVAL '1 minute')"
+
+// reminderRepository implements contract.ReminderRepository
+type reminderRepository struct {
+	db *gorm.DB
+}
+
+// NewReminderRepository creates a new ReminderRepository instance
+func NewReminderRepository(db *gorm.DB) contract.ReminderRepository {
+	return &reminderRepository{db: db}
+}
+
+// withFireTime selects reminders together with their fire time
+func withFireTime(db *gorm.DB) *gorm.DB {
+	return db.
+		Select("reminders.*, " + reminderFireAt + " AS fire_at").
+		Joins("JOIN todos ON todos.id = reminders.todo_id")
+}
+
+// remindedOf scopes a query to the user's reminders on a todo
+func remindedOf(userID, todoID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("reminders.user_id = ? AND reminders.todo_id = ?", userID, todoID)
+	}
+}
+
+// Create creates a new reminder
+func (r *reminderRepository) Create(ctx context.Context, reminder *entity.Reminder) error {
+	result := database.Conn(ctx, r.db).Create(reminder)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds one of the user's reminders on a todo by its ID
+func (r *reminderRepository) FindByID(ctx context.Context, userID, todoID, id uint) (*entity.Reminder, error) {
+	var reminder entity.Reminder
+	result := database.Conn(ctx, r.db).
+		Scopes(withFireTime, remindedOf(userID, todoID)).
+		First(&reminder, "reminders.id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &reminder, nil
+}
+
+// FindByTodo retrieves the user's reminders on a todo, soonest first
+func (r *reminderRepository) FindByTodo(ctx context.Context, userID, todoID uint) ([]entity.Reminder, error) {
+	var reminders []entity.Reminder
+	result := database.Conn(ctx, r.db).
+		Scopes(withFireTime, remindedOf(userID, todoID)).
+		Order("fire_at ASC NULLS LAST, reminders.id").
+		Find(&reminders)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return reminders, nil
+}
+
+// Delete deletes one of the user's reminders on a todo by its ID
+func (r *reminderRepository) Delete(ctx context.Context, userID, todoID, id uint) error {
+	result := database.Conn(ctx, r.db).
+		Scopes(remindedOf(userID, todoID)).
+		Delete(&entity.Reminder{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// CopyRelative copies the relative reminders of one todo to another in a
+// single statement; the copies have not been sent yet
+func (r *reminderRepository) CopyRelative(ctx context.Context, fromTodoID, toTodoID uint) error {
+	result := database.Conn(ctx, r.db).Exec(
+		"INSERT INTO reminders (todo_id, user_id, minutes_before, delivered, created_at, updated_at) "+
+			"SELECT ?, user_id, minutes_before, '[]', NOW(), NOW() FROM reminders "+
+			"WHERE todo_id = ? AND minutes_before IS NOT NULL ORDER BY id",
+		toTodoID, fromTodoID,
+	)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// ClaimDue locks up to limit due reminders, skipping those locked by other
+// schedulers, oldest fire time first, and leases them with one UPDATE.
+// Reminders on completed or trashed todos wait until the todo is reopened
+// or restored.
+func (r *reminderRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.Reminder, error) {
+	db := database.Conn(ctx, r.db)
+	var reminders []entity.Reminder
+	result := db.
+		Select("reminders.*, "+reminderFireAt+" AS fire_at, todos.title AS todo_title, users.email AS user_email").
+		Joins("JOIN todos ON todos.id = reminders.todo_id AND todos.deleted_at IS NULL AND NOT todos.completed").
+		Joins("JOIN users ON users.id = reminders.user_id").
+		Where(reminderFireAt+" <= ?", now).
+		Where("reminders.sent_at IS NULL OR reminders.sent_at < "+reminderFireAt).
+		Where("reminders.retry_at IS NULL OR reminders.retry_at <= ?", now).
+		Order("fire_at, reminders.id").
+		Limit(limit).
+		Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Table:    clause.Table{Name: "reminders"},
+			Options:  "SKIP LOCKED",
+		}).
+		Find(&reminders)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	if len(reminders) == 0 {
+		return reminders, nil
+	}
+
+	ids := make([]uint, 0, len(reminders))
+	for _, reminder := range reminders {
+		ids = append(ids, reminder.ID)
+	}
+	leasedUntil := now.Add(lease)
+	result = db.Model(&entity.Reminder{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"attempts": gorm.Expr("attempts + 1"),
+			"retry_at": leasedUntil,
+		})
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+
+	for i := range reminders {
+		reminders[i].Attempts++
+		reminders[i].RetryAt = &leasedUntil
+	}
+	return reminders, nil
+}
+
+// UpdateDelivery updates the delivery state of a reminder still at the
+// claimed attempt
+func (r *reminderRepository) UpdateDelivery(ctx context.Context, reminder *entity.Reminder, attempt int) error {
+	result := database.Conn(ctx, r.db).
+		Model(reminder).
+		Where("attempts = ?", attempt).
+		Select("sent_at", "attempts", "retry_at", "last_error", "delivered", "updated_at").
+		Updates(reminder)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrVersionConflict
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+const (
+	// MaxPerTodo is the largest number of reminders a user may set on one todo
+	MaxPerTodo = 10
+	// MaxMinutesBefore is the furthest ahead of a due date a reminder may fire
+	MaxMinutesBefore = 365 * 24 * 60
+)
+
+// Service provides the business logic for reminders on todos. The
+// notification scheduler delivers them.
+type Service struct {
+	todos     contract.TodoAccess
+	reminders contract.ReminderRepository
+	tx        contract.Transactor
+}
+
+// NewService creates a new reminder service
+func NewService(todos contract.TodoAccess, reminders contract.ReminderRepository, tx contract.Transactor) *Service {
+	return &Service{todos: todos, reminders: reminders, tx: tx}
+}
+
+// AddInput represents input for setting a reminder on a todo.
+// Exactly one of At, a time in the future, and MinutesBefore the todo's
+// due date is set. A relative reminder on a todo without a due date fires
+// once the todo gets one.
+type AddInput struct {
+	At            *time.Time
+	MinutesBefore *int
+}
+
+// List retrieves the user's reminders on a todo visible to them, soonest
+// first
+func (s *Service) List(ctx context.Context, userID, todoID uint) ([]entity.Reminder, error) {
+	if userID == 0 || todoID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	return s.reminders.FindByTodo(ctx, userID, todoID)
+}
+
+// Add sets a reminder for the user on a todo visible to them. Reminders are
+// personal, so viewers may set them too.
+func (s *Service) Add(ctx context.Context, userID, todoID uint, input AddInput) (*entity.Reminder, error) {
+	if userID == 0 || todoID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	if (input.At == nil) == (input.MinutesBefore == nil) {
+		return nil, fmt.Errorf("%w: set either a time or minutes before the due date", domain.ErrInvalidInput)
+	}
+	if input.At != nil && !input.At.After(time.Now()) {
+		return nil, fmt.Errorf("%w: reminder time must be in the future", domain.ErrInvalidInput)
+	}
+	if input.MinutesBefore != nil && (*input.MinutesBefore < 0 || *input.MinutesBefore > MaxMinutesBefore) {
+		return nil, fmt.Errorf("%w: minutes before the due date must be between 0 and %d", domain.ErrInvalidInput, MaxMinutesBefore)
+	}
+
+	var reminder *entity.Reminder
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+			return err
+		}
+
+		existing, err := s.reminders.FindByTodo(ctx, userID, todoID)
+		if err != nil {
+			return err
+		}
+		if len(existing) >= MaxPerTodo {
+			return fmt.Errorf("%w: a todo can have at most %d reminders", domain.ErrInvalidInput, MaxPerTodo)
+		}
+
+		created := &entity.Reminder{
+			TodoID:        todoID,
+			UserID:        userID,
+			RemindAt:      input.At,
+			MinutesBefore: input.MinutesBefore,
+		}
+		if err := s.reminders.Create(ctx, created); err != nil {
+			return err
+		}
+
+		reminder, err = s.reminders.FindByID(ctx, userID, todoID, created.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
+
+// Delete removes one of the user's reminders on a todo visible to them
+func (s *Service) Delete(ctx context.Context, userID, todoID, reminderID uint) error {
+	if userID == 0 || todoID == 0 || reminderID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+		return err
+	}
+
+	return s.reminders.Delete(ctx, userID, todoID, reminderID)
+}
+
+// CopyToOccurrence gives the next occurrence of a recurring todo the
+// reminders set relative to the due date of the todo it follows
+func (s *Service) CopyToOccurrence(ctx context.Context, todo, next *entity.Todo) error {
+	return s.reminders.CopyRelative(ctx, todo.ID, next.ID)
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
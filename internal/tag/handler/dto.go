@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// CreateTagRequest represents the request body for creating a tag
+type CreateTagRequest struct {
+	Name  string `json:"name" binding:"required,min=1,max=64"`
+	Color string `json:"color" binding:"omitempty,hexcolor"`
+}
+
+// UpdateTagRequest represents the request body for updating a tag
+type UpdateTagRequest struct {
+	Name  *string `json:"name" binding:"omitempty,min=1,max=64"`
+	Color *string `json:"color" binding:"omitempty,hexcolor"`
+}
+
+// TagResponse represents the response body for a tag
+type TagResponse struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Color     string `json:"color"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// NewTagResponse maps a tag entity to its response body
+func NewTagResponse(t *entity.Tag) TagResponse {
+	return TagResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		Color:     t.Color,
+		CreatedAt: FormatTime(t.CreatedAt),
+		UpdatedAt: FormatTime(t.UpdatedAt),
+	}
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
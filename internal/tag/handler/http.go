@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/tag"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for tags
+type Handler struct {
+	service *tag.Service
+}
+
+// NewHandler creates a new tag handler
+func NewHandler(service *tag.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create handles POST /api/v1/tags
+func (h *Handler) Create(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	input := tag.CreateTagInput{
+		Name:  req.Name,
+		Color: req.Color,
+	}
+
+	result, err := h.service.Create(c.Request.Context(), userID, input)
+	if err != nil {
+		if tag.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		if tag.IsDuplicate(err) {
+			response.Conflict(c, "Tag already exists", "a tag with this name already exists")
+			return
+		}
+		response.InternalServerError(c, "Failed to create tag", err.Error())
+		return
+	}
+
+	response.Created(c, "Tag created successfully", NewTagResponse(result))
+}
+
+// GetAll handles GET /api/v1/tags
+func (h *Handler) GetAll(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	tags, err := h.service.GetAll(c.Request.Context(), userID)
+	if err != nil {
+		response.InternalServerError(c, "Failed to get tags", err.Error())
+		return
+	}
+
+	resp := make([]TagResponse, 0, len(tags))
+	for i := range tags {
+		resp = append(resp, NewTagResponse(&tags[i]))
+	}
+
+	response.OK(c, "Tags retrieved successfully", resp)
+}
+
+// GetByID handles GET /api/v1/tags/:id
+func (h *Handler) GetByID(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "tag")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.GetByID(c.Request.Context(), userID, id)
+	if err != nil {
+		if tag.IsNotFound(err) {
+			response.NotFound(c, "Tag not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to get tag", err.Error())
+		return
+	}
+
+	response.OK(c, "Tag retrieved successfully", NewTagResponse(result))
+}
+
+// Update handles PUT /api/v1/tags/:id
+func (h *Handler) Update(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "tag")
+	if !ok {
+		return
+	}
+
+	var req UpdateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	input := tag.UpdateTagInput{
+		Name:  req.Name,
+		Color: req.Color,
+	}
+
+	result, err := h.service.Update(c.Request.Context(), userID, id, input)
+	if err != nil {
+		if tag.IsNotFound(err) {
+			response.NotFound(c, "Tag not found")
+			return
+		}
+		if tag.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		if tag.IsDuplicate(err) {
+			response.Conflict(c, "Tag already exists", "a tag with this name already exists")
+			return
+		}
+		response.InternalServerError(c, "Failed to update tag", err.Error())
+		return
+	}
+
+	response.OK(c, "Tag updated successfully", NewTagResponse(result))
+}
+
+// Delete handles DELETE /api/v1/tags/:id
+func (h *Handler) Delete(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "tag")
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, id); err != nil {
+		if tag.IsNotFound(err) {
+			response.NotFound(c, "Tag not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to delete tag", err.Error())
+		return
+	}
+
+	response.OK(c, "Tag deleted successfully", nil)
+}
@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers tag routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// All tag routes are protected; tags are scoped to the authenticated user
+	tags := router.Group("/tags", auth.AuthMiddleware(jwtManager))
+	{
+		tags.POST("", handler.Create)
+		tags.GET("", handler.GetAll)
+		tags.GET("/:id", handler.GetByID)
+		tags.PUT("/:id", handler.Update)
+		tags.DELETE("/:id", handler.Delete)
+	}
+}
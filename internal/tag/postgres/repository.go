@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// tagRepository implements contract.TagRepository
+type tagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository creates a new TagRepository instance
+func NewTagRepository(db *gorm.DB) contract.TagRepository {
+	return &tagRepository{db: db}
+}
+
+// ownedBy scopes a query to tags belonging to the given user
+func ownedBy(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID)
+	}
+}
+
+// Create creates a new tag
+func (r *tagRepository) Create(ctx context.Context, tag *entity.Tag) error {
+	result := database.Conn(ctx, r.db).Create(tag)
+	if result.Error != nil {
+		// Check for duplicate name
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds a tag by its ID
+func (r *tagRepository) FindByID(ctx context.Context, userID, id uint) (*entity.Tag, error) {
+	var tag entity.Tag
+	result := database.Conn(ctx, r.db).Scopes(ownedBy(userID)).First(&tag, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &tag, nil
+}
+
+// FindByIDs finds the tags with the given IDs
+func (r *tagRepository) FindByIDs(ctx context.Context, userID uint, ids []uint) ([]entity.Tag, error) {
+	var tags []entity.Tag
+	if len(ids) == 0 {
+		return tags, nil
+	}
+	result := database.Conn(ctx, r.db).Scopes(ownedBy(userID)).Where("id IN ?", ids).Find(&tags)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return tags, nil
+}
+
+// FindAll retrieves all tags
+func (r *tagRepository) FindAll(ctx context.Context, userID uint) ([]entity.Tag, error) {
+	var tags []entity.Tag
+	result := database.Conn(ctx, r.db).Scopes(ownedBy(userID)).Order("name ASC").Find(&tags)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return tags, nil
+}
+
+// Update updates an existing tag
+func (r *tagRepository) Update(ctx context.Context, tag *entity.Tag) error {
+	result := database.Conn(ctx, r.db).
+		Model(tag).
+		Scopes(ownedBy(tag.UserID)).
+		Select("*").
+		Omit("id", "user_id", "created_at").
+		Updates(tag)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes a tag by its ID
+func (r *tagRepository) Delete(ctx context.Context, userID, id uint) error {
+	result := database.Conn(ctx, r.db).Scopes(ownedBy(userID)).Delete(&entity.Tag{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
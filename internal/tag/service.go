@@ -0,0 +1,181 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// MaxNameLength is the longest tag name in characters
+const MaxNameLength = 64
+
+// Service provides tag business logic
+type Service struct {
+	repo contract.TagRepository
+}
+
+// NewService creates a new tag service
+func NewService(repo contract.TagRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// CreateTagInput represents input for creating a tag
+type CreateTagInput struct {
+	Name  string
+	Color string
+}
+
+// UpdateTagInput represents input for updating a tag
+type UpdateTagInput struct {
+	Name  *string
+	Color *string
+}
+
+// Create creates a new tag owned by the given user
+func (s *Service) Create(ctx context.Context, userID uint, input CreateTagInput) (*entity.Tag, error) {
+	if userID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	name, err := NormalizeName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &entity.Tag{
+		UserID: userID,
+		Name:   name,
+		Color:  input.Color,
+	}
+
+	if err := s.repo.Create(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// GetByID retrieves a tag by ID owned by the given user
+func (s *Service) GetByID(ctx context.Context, userID, id uint) (*entity.Tag, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.repo.FindByID(ctx, userID, id)
+}
+
+// GetAll retrieves all tags owned by the given user
+func (s *Service) GetAll(ctx context.Context, userID uint) ([]entity.Tag, error) {
+	if userID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.repo.FindAll(ctx, userID)
+}
+
+// Update updates an existing tag owned by the given user
+func (s *Service) Update(ctx context.Context, userID, id uint, input UpdateTagInput) (*entity.Tag, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	tag, err := s.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields if provided
+	if input.Name != nil {
+		name, err := NormalizeName(*input.Name)
+		if err != nil {
+			return nil, err
+		}
+		tag.Name = name
+	}
+	if input.Color != nil {
+		tag.Color = *input.Color
+	}
+
+	if err := s.repo.Update(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// FindOrCreate resolves tag names to the user's tags, keyed by the names
+// as given. Missing tags are created like tags created directly, so names
+// are trimmed and must be valid.
+func (s *Service) FindOrCreate(ctx context.Context, userID uint, names []string) (map[string]entity.Tag, error) {
+	if userID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	existing, err := s.repo.FindAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]entity.Tag, len(existing))
+	for _, tag := range existing {
+		byName[tag.Name] = tag
+	}
+
+	tags := make(map[string]entity.Tag, len(names))
+	for _, name := range names {
+		normalized, err := NormalizeName(name)
+		if err != nil {
+			return nil, err
+		}
+		tag, ok := byName[normalized]
+		if !ok {
+			created, err := s.Create(ctx, userID, CreateTagInput{Name: normalized})
+			if err != nil {
+				return nil, err
+			}
+			tag = *created
+			byName[normalized] = tag
+		}
+		tags[name] = tag
+	}
+	return tags, nil
+}
+
+// Delete deletes a tag by ID owned by the given user.
+// The tag is detached from every todo it was attached to.
+func (s *Service) Delete(ctx context.Context, userID, id uint) error {
+	if userID == 0 || id == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.repo.Delete(ctx, userID, id)
+}
+
+// NormalizeName trims a tag name and checks that it is neither empty nor
+// longer than MaxNameLength
+func NormalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return "", fmt.Errorf("%w: tag names must be between 1 and %d characters long", domain.ErrInvalidInput, MaxNameLength)
+	}
+	return name, nil
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
+
+// IsDuplicate checks if error is a duplicate tag name error
+func IsDuplicate(err error) bool {
+	return errors.Is(err, domain.ErrDuplicateEntry)
+}
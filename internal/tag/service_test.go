@@ -0,0 +1,64 @@
+package tag
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "plain",
+			input: "work",
+			want:  "work",
+		},
+		{
+			name:  "trimmed",
+			input: "  work \t",
+			want:  "work",
+		},
+		{
+			name:  "longest name counts characters, not bytes",
+			input: strings.Repeat("é", MaxNameLength),
+			want:  strings.Repeat("é", MaxNameLength),
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "only whitespace",
+			input:   "   ",
+			wantErr: true,
+		},
+		{
+			name:    "too long",
+			input:   strings.Repeat("a", MaxNameLength+1),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeName(tt.input)
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("NormalizeName(%q) error = %v, want invalid input", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeName(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeName(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// CreateTemplateRequest represents the request body for creating a
+// template. title, description, items and tags may hold {{name}}
+// placeholders; due_offset is relative to the instantiation, such as "+3d",
+// "+1w2d" or "+4h".
+type CreateTemplateRequest struct {
+	Name        string   `json:"name" binding:"required,min=1,max=64"`
+	Title       string   `json:"title" binding:"required,min=1,max=255"`
+	Description string   `json:"description" binding:"max=1000"`
+	Priority    string   `json:"priority" binding:"omitempty,oneof=low medium high urgent"`
+	DueOffset   string   `json:"due_offset" binding:"max=32"`
+	Items       []string `json:"items" binding:"max=100,dive,min=1,max=255"`
+	Tags        []string `json:"tags" binding:"max=20,dive,min=1,max=64"`
+}
+
+// UpdateTemplateRequest represents the request body for updating a
+// template. items and tags replace the template's lists when present.
+type UpdateTemplateRequest struct {
+	Name        *string   `json:"name" binding:"omitempty,min=1,max=64"`
+	Title       *string   `json:"title" binding:"omitempty,min=1,max=255"`
+	Description *string   `json:"description" binding:"omitempty,max=1000"`
+	Priority    *string   `json:"priority" binding:"omitempty,oneof=low medium high urgent"`
+	DueOffset   *string   `json:"due_offset" binding:"omitempty,max=32"`
+	Items       *[]string `json:"items" binding:"omitempty,max=100,dive,min=1,max=255"`
+	Tags        *[]string `json:"tags" binding:"omitempty,max=20,dive,min=1,max=64"`
+}
+
+// InstantiateRequest represents the request body for creating a todo from
+// a template. variables fill the template's placeholders; the due offset
+// counts from base (RFC3339, now when omitted) in the IANA timezone
+// (UTC when omitted). project_id places the todo in a project.
+type InstantiateRequest struct {
+	Variables map[string]string `json:"variables" binding:"max=50"`
+	ProjectID *uint             `json:"project_id" binding:"omitempty,min=1"`
+	Base      *time.Time        `json:"base"`
+	Timezone  string            `json:"timezone" binding:"max=64"`
+}
+
+// TemplateResponse represents the response body for a template
+type TemplateResponse struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Priority    string   `json:"priority"`
+	DueOffset   string   `json:"due_offset"`
+	Items       []string `json:"items"`
+	Tags        []string `json:"tags"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// NewTemplateResponse maps a template entity to its response body
+func NewTemplateResponse(t *entity.Template) TemplateResponse {
+	items := append([]string{}, t.Items...)
+	tags := append([]string{}, t.Tags...)
+	return TemplateResponse{
+		ID:          t.ID,
+		Name:        t.Name,
+		Title:       t.Title,
+		Description: t.Description,
+		Priority:    string(t.Priority),
+		DueOffset:   t.DueOffset,
+		Items:       items,
+		Tags:        tags,
+		CreatedAt:   FormatTime(t.CreatedAt),
+		UpdatedAt:   FormatTime(t.UpdatedAt),
+	}
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/template"
+	todohandler "github.com/arulkarim/golden-architecture/internal/todo/handler"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for todo templates
+type Handler struct {
+	service *template.Service
+}
+
+// NewHandler creates a new template handler
+func NewHandler(service *template.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create handles POST /api/v1/templates
+func (h *Handler) Create(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req CreateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	input := template.CreateTemplateInput{
+		Name:        req.Name,
+		Title:       req.Title,
+		Description: req.Description,
+		Priority:    entity.TodoPriority(req.Priority),
+		DueOffset:   req.DueOffset,
+		Items:       req.Items,
+		Tags:        req.Tags,
+	}
+
+	result, err := h.service.Create(c.Request.Context(), userID, input)
+	if err != nil {
+		if template.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		if template.IsDuplicate(err) {
+			response.Conflict(c, "Template already exists", "a template with this name already exists")
+			return
+		}
+		response.InternalServerError(c, "Failed to create template", err.Error())
+		return
+	}
+
+	response.Created(c, "Template created successfully", NewTemplateResponse(result))
+}
+
+// GetAll handles GET /api/v1/templates
+func (h *Handler) GetAll(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	templates, err := h.service.GetAll(c.Request.Context(), userID)
+	if err != nil {
+		response.InternalServerError(c, "Failed to get templates", err.Error())
+		return
+	}
+
+	resp := make([]TemplateResponse, 0, len(templates))
+	for i := range templates {
+		resp = append(resp, NewTemplateResponse(&templates[i]))
+	}
+
+	response.OK(c, "Templates retrieved successfully", resp)
+}
+
+// GetByID handles GET /api/v1/templates/:id
+func (h *Handler) GetByID(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "template")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.GetByID(c.Request.Context(), userID, id)
+	if err != nil {
+		if template.IsNotFound(err) {
+			response.NotFound(c, "Template not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to get template", err.Error())
+		return
+	}
+
+	response.OK(c, "Template retrieved successfully", NewTemplateResponse(result))
+}
+
+// Update handles PUT /api/v1/templates/:id
+func (h *Handler) Update(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "template")
+	if !ok {
+		return
+	}
+
+	var req UpdateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	input := template.UpdateTemplateInput{
+		Name:        req.Name,
+		Title:       req.Title,
+		Description: req.Description,
+		DueOffset:   req.DueOffset,
+		Items:       req.Items,
+		Tags:        req.Tags,
+	}
+	if req.Priority != nil {
+		priority := entity.TodoPriority(*req.Priority)
+		input.Priority = &priority
+	}
+
+	result, err := h.service.Update(c.Request.Context(), userID, id, input)
+	if err != nil {
+		if template.IsNotFound(err) {
+			response.NotFound(c, "Template not found")
+			return
+		}
+		if template.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		if template.IsDuplicate(err) {
+			response.Conflict(c, "Template already exists", "a template with this name already exists")
+			return
+		}
+		response.InternalServerError(c, "Failed to update template", err.Error())
+		return
+	}
+
+	response.OK(c, "Template updated successfully", NewTemplateResponse(result))
+}
+
+// Delete handles DELETE /api/v1/templates/:id
+func (h *Handler) Delete(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "template")
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), userID, id); err != nil {
+		if template.IsNotFound(err) {
+			response.NotFound(c, "Template not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to delete template", err.Error())
+		return
+	}
+
+	response.OK(c, "Template deleted successfully", nil)
+}
+
+// Instantiate handles POST /api/v1/templates/:id/instantiate.
+// Responds with the created todo.
+func (h *Handler) Instantiate(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "template")
+	if !ok {
+		return
+	}
+
+	var req InstantiateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	result, err := h.service.Instantiate(c.Request.Context(), userID, id, template.InstantiateInput{
+		Variables: req.Variables,
+		ProjectID: req.ProjectID,
+		Base:      req.Base,
+		Timezone:  req.Timezone,
+	})
+	if err != nil {
+		switch {
+		case template.IsNotFound(err):
+			response.NotFound(c, "Template or project not found")
+		case template.IsForbidden(err):
+			response.Forbidden(c, "Forbidden", "your role in the project does not allow adding todos")
+		case template.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to instantiate template", err.Error())
+		}
+		return
+	}
+
+	response.Created(c, "Todo created from template successfully", todohandler.NewTodoResponse(result))
+}
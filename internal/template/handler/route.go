@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers template routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// All template routes are protected; templates are private to their owner
+	templates := router.Group("/templates", auth.AuthMiddleware(jwtManager))
+	{
+		templates.POST("", handler.Create)
+		templates.GET("", handler.GetAll)
+		templates.GET("/:id", handler.GetByID)
+		templates.PUT("/:id", handler.Update)
+		templates.DELETE("/:id", handler.Delete)
+		templates.POST("/:id/instantiate", handler.Instantiate)
+	}
+}
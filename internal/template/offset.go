@@ -0,0 +1,49 @@
+package template
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+)
+
+// offsetPattern matches a due offset: an optional sign followed by amounts
+// of weeks, days, hours and minutes in that order, such as "+1w2d"
+var offsetPattern = regexp.MustCompile(`^([+-]?)(?:(\d{1,3})w)?(?:(\d{1,4})d)?(?:(\d{1,5})h)?(?:(\d{1,6})m)?$`)
+
+// offset is a due date relative to the moment a template is instantiated.
+// Weeks and days are calendar days, so they keep the time of day across
+// daylight saving changes; hours and minutes are exact durations.
+type offset struct {
+	days     int
+	duration time.Duration
+}
+
+// parseOffset parses a due offset such as "+3d", "+1w2d", "+4h" or "-1d12h"
+func parseOffset(s string) (offset, error) {
+	s = strings.TrimSpace(s)
+	match := offsetPattern.FindStringSubmatch(s)
+	if match == nil || strings.TrimLeft(s, "+-") == "" {
+		return offset{}, domain.ErrInvalidInput
+	}
+
+	amount := func(i int) int {
+		n, _ := strconv.Atoi(match[i])
+		return n
+	}
+	o := offset{
+		days:     amount(2)*7 + amount(3),
+		duration: time.Duration(amount(4))*time.Hour + time.Duration(amount(5))*time.Minute,
+	}
+	if match[1] == "-" {
+		o.days, o.duration = -o.days, -o.duration
+	}
+	return o, nil
+}
+
+// from returns the time the offset points to from base
+func (o offset) from(base time.Time) time.Time {
+	return base.AddDate(0, 0, o.days).Add(o.duration)
+}
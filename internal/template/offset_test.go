@@ -0,0 +1,92 @@
+package template
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+)
+
+func TestParseOffset(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    offset
+		wantErr bool
+	}{
+		{name: "days", input: "+3d", want: offset{days: 3}},
+		{name: "unsigned", input: "3d", want: offset{days: 3}},
+		{name: "weeks and days", input: "+1w2d", want: offset{days: 9}},
+		{name: "hours", input: "+4h", want: offset{duration: 4 * time.Hour}},
+		{name: "minutes", input: "30m", want: offset{duration: 30 * time.Minute}},
+		{name: "every unit", input: "+1w1d1h1m", want: offset{days: 8, duration: time.Hour + time.Minute}},
+		{name: "negative", input: "-1d12h", want: offset{days: -1, duration: -12 * time.Hour}},
+		{name: "surrounding spaces", input: " +2d ", want: offset{days: 2}},
+		{name: "zero", input: "0d", want: offset{}},
+		{name: "largest amounts", input: "999w9999d99999h999999m", want: offset{days: 999*7 + 9999, duration: 99999*time.Hour + 999999*time.Minute}},
+		{name: "empty", input: "", wantErr: true},
+		{name: "sign only", input: "+", wantErr: true},
+		{name: "double sign", input: "+-1d", wantErr: true},
+		{name: "no unit", input: "3", wantErr: true},
+		{name: "unknown unit", input: "1y", wantErr: true},
+		{name: "uppercase unit", input: "1D", wantErr: true},
+		{name: "units out of order", input: "1d1w", wantErr: true},
+		{name: "repeated unit", input: "1d1d", wantErr: true},
+		{name: "inner space", input: "+1w 2d", wantErr: true},
+		{name: "too many weeks", input: "1000w", wantErr: true},
+		{name: "too many days", input: "10000d", wantErr: true},
+		{name: "too many hours", input: "100000h", wantErr: true},
+		{name: "too many minutes", input: "1000000m", wantErr: true},
+		{name: "overflowing amount", input: "99999999999999999999d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOffset(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidInput) {
+					t.Fatalf("parseOffset(%q) error = %v, want invalid input", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseOffset(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseOffset(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOffsetFrom(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+	// Clocks in Amsterdam move forward an hour on 2026-03-29
+	base := time.Date(2026, 3, 28, 9, 0, 0, 0, amsterdam)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{name: "days keep the time of day", input: "+1d", want: time.Date(2026, 3, 29, 9, 0, 0, 0, amsterdam)},
+		{name: "hours are exact", input: "+24h", want: time.Date(2026, 3, 29, 10, 0, 0, 0, amsterdam)},
+		{name: "backwards", input: "-1w", want: time.Date(2026, 3, 21, 9, 0, 0, 0, amsterdam)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := parseOffset(tt.input)
+			if err != nil {
+				t.Fatalf("parseOffset(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := o.from(base); !got.Equal(tt.want) {
+				t.Errorf("%q from %v = %v, want %v", tt.input, base, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package template
+
+import (
+	"regexp"
+	"slices"
+)
+
+// placeholderPattern matches a {{name}} placeholder, capturing the name
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// variableName matches the names placeholders can refer to
+var variableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// placeholders returns the distinct variable names used in the texts in
+// order of first appearance
+func placeholders(texts ...string) []string {
+	var names []string
+	for _, text := range texts {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+			if !slices.Contains(names, match[1]) {
+				names = append(names, match[1])
+			}
+		}
+	}
+	return names
+}
+
+// fill replaces the placeholders in text with the values of their
+// variables. Values are inserted verbatim, so placeholders within them are
+// left alone.
+func fill(text string, variables map[string]string) string {
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := placeholderPattern.FindStringSubmatch(placeholder)[1]
+		if value, ok := variables[name]; ok {
+			return value
+		}
+		return placeholder
+	})
+}
@@ -0,0 +1,62 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlaceholders(t *testing.T) {
+	tests := []struct {
+		name  string
+		texts []string
+		want  []string
+	}{
+		{name: "none", texts: []string{"Weekly review"}},
+		{name: "in order of first appearance", texts: []string{"{{client}}: {{ topic }}", "Ask {{client}} about {{date}}"}, want: []string{"client", "topic", "date"}},
+		{name: "underscores and digits", texts: []string{"{{_first}} {{Q4_goal}}"}, want: []string{"_first", "Q4_goal"}},
+		{name: "name starting with a digit is text", texts: []string{"{{4q}}"}},
+		{name: "name with a dash is text", texts: []string{"{{due-date}}"}},
+		{name: "single braces are text", texts: []string{"{client} { {client} }"}},
+		{name: "unterminated", texts: []string{"{{client"}},
+		{name: "extra braces around a placeholder", texts: []string{"{{{client}}}"}, want: []string{"client"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := placeholders(tt.texts...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("placeholders(%q) = %v, want %v", tt.texts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFill(t *testing.T) {
+	variables := map[string]string{
+		"client": "ACME",
+		"topic":  "{{client}} renewal",
+		"empty":  "",
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "known", text: "Call {{client}}", want: "Call ACME"},
+		{name: "spaces inside the braces", text: "Call {{ client }}", want: "Call ACME"},
+		{name: "repeated", text: "{{client}}/{{client}}", want: "ACME/ACME"},
+		{name: "empty value", text: "a{{empty}}b", want: "ab"},
+		{name: "unknown is left alone", text: "Call {{contact}}", want: "Call {{contact}}"},
+		{name: "values are not filled in again", text: "Discuss {{topic}}", want: "Discuss {{client}} renewal"},
+		{name: "invalid name is left alone", text: "{{ 1client }}", want: "{{ 1client }}"},
+		{name: "extra braces are kept", text: "{{{client}}}", want: "{ACME}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fill(tt.text, variables); got != tt.want {
+				t.Errorf("fill(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// templateRepository implements contract.TemplateRepository
+type templateRepository struct {
+	db *gorm.DB
+}
+
+// NewTemplateRepository creates a new TemplateRepository instance
+func NewTemplateRepository(db *gorm.DB) contract.TemplateRepository {
+	return &templateRepository{db: db}
+}
+
+// ownedBy scopes a query to templates belonging to the given user
+func ownedBy(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID)
+	}
+}
+
+// Create creates a new template
+func (r *templateRepository) Create(ctx context.Context, template *entity.Template) error {
+	result := database.Conn(ctx, r.db).Create(template)
+	if result.Error != nil {
+		// Check for duplicate name
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds a template by its ID
+func (r *templateRepository) FindByID(ctx context.Context, userID, id uint) (*entity.Template, error) {
+	var template entity.Template
+	result := database.Conn(ctx, r.db).Scopes(ownedBy(userID)).First(&template, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &template, nil
+}
+
+// FindAll retrieves all templates
+func (r *templateRepository) FindAll(ctx context.Context, userID uint) ([]entity.Template, error) {
+	var templates []entity.Template
+	result := database.Conn(ctx, r.db).Scopes(ownedBy(userID)).Order("name ASC").Find(&templates)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return templates, nil
+}
+
+// Update updates an existing template
+func (r *templateRepository) Update(ctx context.Context, template *entity.Template) error {
+	result := database.Conn(ctx, r.db).
+		Model(template).
+		Scopes(ownedBy(template.UserID)).
+		Select("*").
+		Omit("id", "user_id", "created_at").
+		Updates(template)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes a template by its ID
+func (r *templateRepository) Delete(ctx context.Context, userID, id uint) error {
+	result := database.Conn(ctx, r.db).Scopes(ownedBy(userID)).Delete(&entity.Template{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+)
+
+const (
+	// MaxVariables is the largest number of variables an instantiation may pass
+	MaxVariables = 50
+	// MaxVariableLength is the longest variable value in characters
+	MaxVariableLength = 1000
+)
+
+// Service provides todo template business logic. Templates are turned into
+// todos through the todo service, so instantiated todos follow the same
+// rules as todos created directly.
+type Service struct {
+	repo  contract.TemplateRepository
+	todos *todo.Service
+}
+
+// NewService creates a new template service
+func NewService(repo contract.TemplateRepository, todos *todo.Service) *Service {
+	return &Service{repo: repo, todos: todos}
+}
+
+// CreateTemplateInput represents input for creating a template
+type CreateTemplateInput struct {
+	Name        string
+	Title       string
+	Description string
+	Priority    entity.TodoPriority
+	DueOffset   string
+	Items       []string
+	Tags        []string
+}
+
+// UpdateTemplateInput represents input for updating a template. Items and
+// Tags replace the template's lists when set.
+type UpdateTemplateInput struct {
+	Name        *string
+	Title       *string
+	Description *string
+	Priority    *entity.TodoPriority
+	DueOffset   *string
+	Items       *[]string
+	Tags        *[]string
+}
+
+// InstantiateInput represents input for creating a todo from a template.
+// Variables fill the template's placeholders and must cover all of them.
+// The due offset counts from Base, or from now when nil, in the IANA
+// Timezone (UTC when empty). ProjectID places the todo in a project the
+// user can edit instead of their inbox.
+type InstantiateInput struct {
+	Variables map[string]string
+	ProjectID *uint
+	Base      *time.Time
+	Timezone  string
+}
+
+// Create creates a new template owned by the given user
+func (s *Service) Create(ctx context.Context, userID uint, input CreateTemplateInput) (*entity.Template, error) {
+	if userID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	template := &entity.Template{
+		UserID:      userID,
+		Name:        input.Name,
+		Title:       input.Title,
+		Description: input.Description,
+		Priority:    input.Priority,
+		DueOffset:   input.DueOffset,
+		Items:       input.Items,
+		Tags:        input.Tags,
+	}
+	if err := normalize(template); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GetByID retrieves a template by ID owned by the given user
+func (s *Service) GetByID(ctx context.Context, userID, id uint) (*entity.Template, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.repo.FindByID(ctx, userID, id)
+}
+
+// GetAll retrieves all templates owned by the given user
+func (s *Service) GetAll(ctx context.Context, userID uint) ([]entity.Template, error) {
+	if userID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.repo.FindAll(ctx, userID)
+}
+
+// Update updates an existing template owned by the given user
+func (s *Service) Update(ctx context.Context, userID, id uint, input UpdateTemplateInput) (*entity.Template, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	template, err := s.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields if provided
+	if input.Name != nil {
+		template.Name = *input.Name
+	}
+	if input.Title != nil {
+		template.Title = *input.Title
+	}
+	if input.Description != nil {
+		template.Description = *input.Description
+	}
+	if input.Priority != nil {
+		template.Priority = *input.Priority
+	}
+	if input.DueOffset != nil {
+		template.DueOffset = *input.DueOffset
+	}
+	if input.Items != nil {
+		template.Items = *input.Items
+	}
+	if input.Tags != nil {
+		template.Tags = *input.Tags
+	}
+	if err := normalize(template); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// Delete deletes a template by ID owned by the given user. Todos created
+// from it are kept.
+func (s *Service) Delete(ctx context.Context, userID, id uint) error {
+	if userID == 0 || id == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.repo.Delete(ctx, userID, id)
+}
+
+// Instantiate creates a todo with the checklist items and tags of one of
+// the user's templates, its placeholders filled from the variables, in one
+// transaction
+func (s *Service) Instantiate(ctx context.Context, userID, id uint, input InstantiateInput) (*entity.Todo, error) {
+	if userID == 0 || id == 0 || len(input.Variables) > MaxVariables {
+		return nil, domain.ErrInvalidInput
+	}
+	for name, value := range input.Variables {
+		if !variableName.MatchString(name) || utf8.RuneCountInString(value) > MaxVariableLength {
+			return nil, fmt.Errorf("%w: invalid variable %q", domain.ErrInvalidInput, name)
+		}
+	}
+	loc := time.UTC
+	if input.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(input.Timezone); err != nil {
+			return nil, fmt.Errorf("%w: unknown timezone %q", domain.ErrInvalidInput, input.Timezone)
+		}
+	}
+
+	template, err := s.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := append([]string{template.Title, template.Description}, template.Items...)
+	texts = append(texts, template.Tags...)
+	var missing []string
+	for _, name := range placeholders(texts...) {
+		if _, ok := input.Variables[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing variables %s", domain.ErrInvalidInput, strings.Join(missing, ", "))
+	}
+
+	create := todo.CreateTreeInput{
+		CreateTodoInput: todo.CreateTodoInput{
+			Title:       strings.TrimSpace(fill(template.Title, input.Variables)),
+			Description: fill(template.Description, input.Variables),
+			Priority:    template.Priority,
+		},
+		ProjectID: input.ProjectID,
+		Items:     make([]string, 0, len(template.Items)),
+		Tags:      make([]string, 0, len(template.Tags)),
+	}
+	if utf8.RuneCountInString(create.Title) > 255 {
+		return nil, fmt.Errorf("%w: the filled in title is longer than 255 characters", domain.ErrInvalidInput)
+	}
+	for _, item := range template.Items {
+		create.Items = append(create.Items, fill(item, input.Variables))
+	}
+	for _, tag := range template.Tags {
+		create.Tags = append(create.Tags, fill(tag, input.Variables))
+	}
+
+	if template.DueOffset != "" {
+		offset, err := parseOffset(template.DueOffset)
+		if err != nil {
+			return nil, err
+		}
+		base := time.Now()
+		if input.Base != nil {
+			base = *input.Base
+		}
+		dueAt := offset.from(base.In(loc))
+		create.DueAt = &dueAt
+	}
+
+	return s.todos.CreateTree(ctx, userID, create)
+}
+
+// normalize trims a template's fields and checks that they make a valid
+// todo once filled in
+func normalize(template *entity.Template) error {
+	template.Name = strings.TrimSpace(template.Name)
+	template.Title = strings.TrimSpace(template.Title)
+	template.DueOffset = strings.TrimSpace(template.DueOffset)
+	if template.Priority == "" {
+		template.Priority = entity.PriorityMedium
+	}
+
+	if template.Name == "" || utf8.RuneCountInString(template.Name) > 64 ||
+		template.Title == "" || utf8.RuneCountInString(template.Title) > 255 ||
+		utf8.RuneCountInString(template.Description) > 1000 ||
+		!template.Priority.IsValid() ||
+		len(template.Items) > todo.MaxTreeItems || len(template.Tags) > todo.MaxTreeTags {
+		return domain.ErrInvalidInput
+	}
+	if template.DueOffset != "" {
+		if _, err := parseOffset(template.DueOffset); err != nil {
+			return fmt.Errorf("%w: invalid due offset %q", domain.ErrInvalidInput, template.DueOffset)
+		}
+	}
+
+	items := make(entity.TextList, 0, len(template.Items))
+	for _, item := range template.Items {
+		item = strings.TrimSpace(item)
+		if item == "" || utf8.RuneCountInString(item) > 255 {
+			return domain.ErrInvalidInput
+		}
+		items = append(items, item)
+	}
+	tags := make(entity.TextList, 0, len(template.Tags))
+	for _, tag := range template.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > 64 {
+			return domain.ErrInvalidInput
+		}
+		tags = append(tags, tag)
+	}
+	template.Items, template.Tags = items, tags
+	return nil
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
+
+// IsDuplicate checks if error is a duplicate template name error
+func IsDuplicate(err error) bool {
+	return errors.Is(err, domain.ErrDuplicateEntry)
+}
+
+// IsForbidden checks if error is caused by instantiating into a project
+// the user cannot edit
+func IsForbidden(err error) bool {
+	return errors.Is(err, domain.ErrForbidden)
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// ListTimeEntriesRequest represents the query parameters for listing a todo's time entries
+type ListTimeEntriesRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// StartTimerRequest represents the optional request body for starting a timer
+type StartTimerRequest struct {
+	Note string `json:"note" binding:"max=1000"`
+}
+
+// CreateTimeEntryRequest represents the request body for entering time by
+// hand. started_at and ended_at are RFC3339 timestamps.
+type CreateTimeEntryRequest struct {
+	StartedAt time.Time `json:"started_at" binding:"required"`
+	EndedAt   time.Time `json:"ended_at" binding:"required"`
+	Note      string    `json:"note" binding:"max=1000"`
+}
+
+// UpdateTimeEntryRequest represents the request body for changing a time
+// entry. Setting ended_at on a running entry stops its timer.
+type UpdateTimeEntryRequest struct {
+	StartedAt *time.Time `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	Note      *string    `json:"note" binding:"omitempty,max=1000"`
+}
+
+// TimeEntryResponse represents the response body for a time entry.
+// ended_at is null and duration_seconds counts up to now while the timer
+// is running.
+type TimeEntryResponse struct {
+	ID              uint    `json:"id"`
+	TodoID          uint    `json:"todo_id"`
+	UserID          uint    `json:"user_id"`
+	UserEmail       string  `json:"user_email"`
+	StartedAt       string  `json:"started_at"`
+	EndedAt         *string `json:"ended_at"`
+	Running         bool    `json:"running"`
+	DurationSeconds int64   `json:"duration_seconds"`
+	Note            string  `json:"note"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// TimeEntryListResponse represents the response body for a todo's time
+// entries. tracked_seconds is the time tracked on the todo by everyone,
+// running timers up to now.
+type TimeEntryListResponse struct {
+	Entries        []TimeEntryResponse `json:"entries"`
+	TrackedSeconds int64               `json:"tracked_seconds"`
+	Total          int64               `json:"total"`
+	Page           int                 `json:"page"`
+	PageSize       int                 `json:"page_size"`
+	TotalPages     int                 `json:"total_pages"`
+}
+
+// NewTimeEntryResponse maps a time entry entity to its response body
+func NewTimeEntryResponse(e *entity.TimeEntry, now time.Time) TimeEntryResponse {
+	return TimeEntryResponse{
+		ID:              e.ID,
+		TodoID:          e.TodoID,
+		UserID:          e.UserID,
+		UserEmail:       e.UserEmail,
+		StartedAt:       FormatTime(e.StartedAt),
+		EndedAt:         FormatOptionalTime(e.EndedAt),
+		Running:         e.Running(),
+		DurationSeconds: int64(e.Duration(now) / time.Second),
+		Note:            e.Note,
+		CreatedAt:       FormatTime(e.CreatedAt),
+	}
+}
+
+// TimeReportRequest represents the query parameters for a time report.
+// from and to are inclusive dates in the IANA timezone given by tz (UTC
+// when omitted), at most 366 days apart.
+type TimeReportRequest struct {
+	From    string `form:"from" binding:"required,datetime=2006-01-02"`
+	To      string `form:"to" binding:"required,datetime=2006-01-02"`
+	GroupBy string `form:"group_by" binding:"required,oneof=day project tag"`
+	TZ      string `form:"tz"`
+}
+
+// TimeReportGroupResponse represents the time tracked in one group of a
+// report. key is the day (YYYY-MM-DD) or the project or tag name; id is
+// null for days, the inbox and untagged todos.
+type TimeReportGroupResponse struct {
+	Key     string `json:"key"`
+	ID      *uint  `json:"id"`
+	Seconds int64  `json:"seconds"`
+}
+
+// TimeReportResponse represents the response body for a time report.
+// total_seconds counts every entry once, even when it falls into several
+// tag groups.
+type TimeReportResponse struct {
+	From         string                    `json:"from"`
+	To           string                    `json:"to"`
+	GroupBy      string                    `json:"group_by"`
+	TotalSeconds int64                     `json:"total_seconds"`
+	Groups       []TimeReportGroupResponse `json:"groups"`
+}
+
+// FormatTime formats time to RFC3339
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// FormatOptionalTime formats an optional time to RFC3339, keeping nil as nil
+func FormatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := FormatTime(*t)
+	return &formatted
+}
@@ -0,0 +1,341 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/timetracking"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// reportDate is the layout of the dates a time report covers
+const reportDate = "2006-01-02"
+
+// Handler handles HTTP requests for time tracked on todos
+type Handler struct {
+	service *timetracking.Service
+}
+
+// NewHandler creates a new time tracking handler
+func NewHandler(service *timetracking.Service) *Handler {
+	return &Handler{service: service}
+}
+
+// parseLocation resolves an optional IANA timezone name, defaulting to UTC
+func parseLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// StartTimer handles POST /api/v1/todos/:id/timer/start.
+// The body is optional.
+func (h *Handler) StartTimer(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req StartTimerRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	entry, err := h.service.StartTimer(c.Request.Context(), userID, todoID, timetracking.StartTimerInput{Note: req.Note})
+	if err != nil {
+		switch {
+		case timetracking.IsNotFound(err):
+			response.NotFound(c, "Todo not found")
+		case timetracking.IsForbidden(err):
+			auth.ReadOnly(c)
+		case timetracking.IsTimerRunning(err):
+			response.Conflict(c, "Timer already running", "stop your running timer before starting another one")
+		case timetracking.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to start timer", err.Error())
+		}
+		return
+	}
+
+	response.Created(c, "Timer started successfully", NewTimeEntryResponse(entry, time.Now()))
+}
+
+// StopTimer handles POST /api/v1/todos/:id/timer/stop
+func (h *Handler) StopTimer(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	entry, err := h.service.StopTimer(c.Request.Context(), userID, todoID)
+	if err != nil {
+		if timetracking.IsNotFound(err) {
+			response.NotFound(c, "No running timer on this todo")
+			return
+		}
+		if timetracking.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to stop timer", err.Error())
+		return
+	}
+
+	response.OK(c, "Timer stopped successfully", NewTimeEntryResponse(entry, time.Now()))
+}
+
+// RunningTimer handles GET /api/v1/time/timer
+func (h *Handler) RunningTimer(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.service.RunningTimer(c.Request.Context(), userID)
+	if err != nil {
+		if timetracking.IsNotFound(err) {
+			response.NotFound(c, "No running timer")
+			return
+		}
+		response.InternalServerError(c, "Failed to get running timer", err.Error())
+		return
+	}
+
+	response.OK(c, "Running timer retrieved successfully", NewTimeEntryResponse(entry, time.Now()))
+}
+
+// ListEntries handles GET /api/v1/todos/:id/time-entries
+func (h *Handler) ListEntries(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req ListTimeEntriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	result, err := h.service.ListEntries(c.Request.Context(), userID, todoID, timetracking.ListEntriesInput{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		if timetracking.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if timetracking.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get time entries", err.Error())
+		return
+	}
+
+	now := time.Now()
+	entries := make([]TimeEntryResponse, 0, len(result.Entries))
+	for i := range result.Entries {
+		entries = append(entries, NewTimeEntryResponse(&result.Entries[i], now))
+	}
+
+	resp := TimeEntryListResponse{
+		Entries:        entries,
+		TrackedSeconds: int64(result.Tracked / time.Second),
+		Total:          result.Total,
+		Page:           result.Page,
+		PageSize:       result.PageSize,
+		TotalPages:     result.TotalPages(),
+	}
+
+	response.OK(c, "Time entries retrieved successfully", resp)
+}
+
+// CreateEntry handles POST /api/v1/todos/:id/time-entries
+func (h *Handler) CreateEntry(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req CreateTimeEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	entry, err := h.service.AddEntry(c.Request.Context(), userID, todoID, timetracking.AddEntryInput{
+		StartedAt: req.StartedAt,
+		EndedAt:   req.EndedAt,
+		Note:      req.Note,
+	})
+	if err != nil {
+		switch {
+		case timetracking.IsNotFound(err):
+			response.NotFound(c, "Todo not found")
+		case timetracking.IsForbidden(err):
+			auth.ReadOnly(c)
+		case timetracking.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", "ended_at must be after started_at, which must not be in the future")
+		default:
+			response.InternalServerError(c, "Failed to add time entry", err.Error())
+		}
+		return
+	}
+
+	response.Created(c, "Time entry added successfully", NewTimeEntryResponse(entry, time.Now()))
+}
+
+// UpdateEntry handles PUT /api/v1/todos/:id/time-entries/:entryId
+func (h *Handler) UpdateEntry(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	entryID, ok := param.ID(c, "entryId", "time entry")
+	if !ok {
+		return
+	}
+
+	var req UpdateTimeEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	entry, err := h.service.UpdateEntry(c.Request.Context(), userID, todoID, entryID, timetracking.UpdateEntryInput{
+		StartedAt: req.StartedAt,
+		EndedAt:   req.EndedAt,
+		Note:      req.Note,
+	})
+	if err != nil {
+		switch {
+		case timetracking.IsNotFound(err):
+			response.NotFound(c, "Time entry not found")
+		case timetracking.IsForbidden(err):
+			response.Forbidden(c, "Forbidden", "only the user who tracked the time can change it")
+		case timetracking.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", "ended_at must be after started_at, which must not be in the future")
+		default:
+			response.InternalServerError(c, "Failed to update time entry", err.Error())
+		}
+		return
+	}
+
+	response.OK(c, "Time entry updated successfully", NewTimeEntryResponse(entry, time.Now()))
+}
+
+// DeleteEntry handles DELETE /api/v1/todos/:id/time-entries/:entryId
+func (h *Handler) DeleteEntry(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	entryID, ok := param.ID(c, "entryId", "time entry")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteEntry(c.Request.Context(), userID, todoID, entryID); err != nil {
+		switch {
+		case timetracking.IsNotFound(err):
+			response.NotFound(c, "Time entry not found")
+		case timetracking.IsForbidden(err):
+			response.Forbidden(c, "Forbidden", "only the user who tracked the time can delete it")
+		case timetracking.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to delete time entry", err.Error())
+		}
+		return
+	}
+
+	response.OK(c, "Time entry deleted successfully", nil)
+}
+
+// Report handles GET /api/v1/time/report
+func (h *Handler) Report(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req TimeReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+	location, err := parseLocation(req.TZ)
+	// Days are grouped by the database, which knows no "Local" zone
+	if err != nil || location == time.Local {
+		response.BadRequest(c, "Invalid query parameters", "tz must be an IANA timezone")
+		return
+	}
+	// The layout is already checked by binding
+	from, _ := time.Parse(reportDate, req.From)
+	to, _ := time.Parse(reportDate, req.To)
+
+	report, err := h.service.Report(c.Request.Context(), userID, timetracking.ReportInput{
+		From:     from,
+		To:       to,
+		GroupBy:  req.GroupBy,
+		Location: location,
+	})
+	if err != nil {
+		if timetracking.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", "to must not be before from, and the range must cover at most 366 days")
+			return
+		}
+		response.InternalServerError(c, "Failed to report time", err.Error())
+		return
+	}
+
+	resp := TimeReportResponse{
+		From:         report.From.Format(reportDate),
+		To:           report.To.Format(reportDate),
+		GroupBy:      report.GroupBy,
+		TotalSeconds: int64(report.Total / time.Second),
+		Groups:       make([]TimeReportGroupResponse, 0, len(report.Groups)),
+	}
+	for _, group := range report.Groups {
+		resp.Groups = append(resp.Groups, TimeReportGroupResponse{
+			Key:     group.Key,
+			ID:      group.ID,
+			Seconds: int64(group.Duration / time.Second),
+		})
+	}
+
+	response.OK(c, "Time report retrieved successfully", resp)
+}
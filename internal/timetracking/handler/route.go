@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers time tracking routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// Timers and time entries live under the todo the time was spent on
+	todos := router.Group("/todos", auth.AuthMiddleware(jwtManager))
+	{
+		todos.POST("/:id/timer/start", handler.StartTimer)
+		todos.POST("/:id/timer/stop", handler.StopTimer)
+		todos.GET("/:id/time-entries", handler.ListEntries)
+		todos.POST("/:id/time-entries", handler.CreateEntry)
+		todos.PUT("/:id/time-entries/:entryId", handler.UpdateEntry)
+		todos.DELETE("/:id/time-entries/:entryId", handler.DeleteEntry)
+	}
+
+	// Time tracked by the authenticated user across their todos
+	tracked := router.Group("/time", auth.AuthMiddleware(jwtManager))
+	{
+		tracked.GET("/timer", handler.RunningTimer)
+		tracked.GET("/report", handler.Report)
+	}
+}
@@ -0,0 +1,221 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// trackedSeconds is the length of a time entry in seconds; running timers
+// count up to the start of the transaction
+const trackedSeconds = "EXTRACT(EPOCH FROM COALESCE(time_entries.ended_at, NOW()) - time_entries.started_at)"
+
+// timeEntryRepository implements contract.TimeEntryRepository
+type timeEntryRepository struct {
+	db *gorm.DB
+}
+
+// NewTimeEntryRepository creates a new TimeEntryRepository instance
+func NewTimeEntryRepository(db *gorm.DB) contract.TimeEntryRepository {
+	return &timeEntryRepository{db: db}
+}
+
+// withTracker selects time entries together with their user's email
+func withTracker(db *gorm.DB) *gorm.DB {
+	return db.
+		Select("time_entries.*, users.email AS user_email").
+		Joins("JOIN users ON users.id = time_entries.user_id")
+}
+
+// Create creates a new time entry
+func (r *timeEntryRepository) Create(ctx context.Context, entry *entity.TimeEntry) error {
+	result := database.Conn(ctx, r.db).Create(entry)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return domain.ErrDuplicateEntry
+		}
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds a time entry of a todo by its ID
+func (r *timeEntryRepository) FindByID(ctx context.Context, todoID, id uint) (*entity.TimeEntry, error) {
+	var entry entity.TimeEntry
+	result := database.Conn(ctx, r.db).
+		Scopes(withTracker).
+		Where("time_entries.todo_id = ?", todoID).
+		First(&entry, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &entry, nil
+}
+
+// FindByTodo retrieves one page of a todo's time entries, latest first
+func (r *timeEntryRepository) FindByTodo(ctx context.Context, todoID uint, limit, offset int) ([]entity.TimeEntry, int64, error) {
+	var total int64
+	result := database.Conn(ctx, r.db).
+		Model(&entity.TimeEntry{}).
+		Where("todo_id = ?", todoID).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	var entries []entity.TimeEntry
+	result = database.Conn(ctx, r.db).
+		Scopes(withTracker).
+		Where("time_entries.todo_id = ?", todoID).
+		Order("time_entries.started_at DESC, time_entries.id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&entries)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+	return entries, total, nil
+}
+
+// FindRunning finds the user's running timer, locking it
+func (r *timeEntryRepository) FindRunning(ctx context.Context, userID uint) (*entity.TimeEntry, error) {
+	var entry entity.TimeEntry
+	result := database.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND ended_at IS NULL", userID).
+		First(&entry)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &entry, nil
+}
+
+// Update updates the start, end and note of an existing time entry
+func (r *timeEntryRepository) Update(ctx context.Context, entry *entity.TimeEntry) error {
+	result := database.Conn(ctx, r.db).
+		Model(entry).
+		Where("todo_id = ?", entry.TodoID).
+		Select("started_at", "ended_at", "note", "updated_at").
+		Updates(entry)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes a time entry of a todo by its ID
+func (r *timeEntryRepository) Delete(ctx context.Context, todoID, id uint) error {
+	result := database.Conn(ctx, r.db).Where("todo_id = ?", todoID).Delete(&entity.TimeEntry{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// timeTotalRow is the tracked time of one report group
+type timeTotalRow struct {
+	GroupKey string
+	GroupID  *uint
+	Seconds  float64
+}
+
+// Report sums a user's tracked time per day, project or tag
+func (r *timeEntryRepository) Report(ctx context.Context, q contract.TimeReportQuery) ([]contract.TimeTotal, time.Duration, error) {
+	loc := q.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	reported := func(db *gorm.DB) *gorm.DB {
+		return db.
+			Model(&entity.TimeEntry{}).
+			Where("time_entries.user_id = ? AND time_entries.started_at >= ? AND time_entries.started_at < ?", q.UserID, q.From, q.Before)
+	}
+
+	var total float64
+	result := database.Conn(ctx, r.db).
+		Scopes(reported).
+		Select("COALESCE(SUM(" + trackedSeconds + "), 0)").
+		Scan(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	query := database.Conn(ctx, r.db).Scopes(reported)
+	switch q.GroupBy {
+	case contract.TimeByDay:
+		query = query.
+			Select("to_char(time_entries.started_at AT TIME ZONE ?, 'YYYY-MM-DD') AS group_key, SUM("+trackedSeconds+") AS seconds", loc.String()).
+			Group("group_key").
+			Order("group_key")
+	case contract.TimeByProject:
+		query = query.
+			Select("COALESCE(projects.name, '') AS group_key, projects.id AS group_id, SUM(" + trackedSeconds + ") AS seconds").
+			Joins("JOIN todos ON todos.id = time_entries.todo_id").
+			Joins("LEFT JOIN projects ON projects.id = todos.project_id").
+			Group("projects.id, projects.name").
+			Order("seconds DESC, group_key")
+	case contract.TimeByTag:
+		query = query.
+			Select("COALESCE(tags.name, '') AS group_key, tags.id AS group_id, SUM(" + trackedSeconds + ") AS seconds").
+			Joins("LEFT JOIN todo_tags ON todo_tags.todo_id = time_entries.todo_id").
+			Joins("LEFT JOIN tags ON tags.id = todo_tags.tag_id").
+			Group("tags.id, tags.name").
+			Order("seconds DESC, group_key")
+	default:
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	var rows []timeTotalRow
+	if result := query.Scan(&rows); result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	totals := make([]contract.TimeTotal, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, contract.TimeTotal{
+			Key:      row.GroupKey,
+			ID:       row.GroupID,
+			Duration: seconds(row.Seconds),
+		})
+	}
+	return totals, seconds(total), nil
+}
+
+// TotalByTodo sums the time tracked on a todo by every user
+func (r *timeEntryRepository) TotalByTodo(ctx context.Context, todoID uint) (time.Duration, error) {
+	var total float64
+	result := database.Conn(ctx, r.db).
+		Model(&entity.TimeEntry{}).
+		Select("COALESCE(SUM("+trackedSeconds+"), 0)").
+		Where("todo_id = ?", todoID).
+		Scan(&total)
+	if result.Error != nil {
+		return 0, domain.ErrDatabaseOperation
+	}
+	return seconds(total), nil
+}
+
+// seconds converts a number of seconds to a duration, rounded to the second
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Round(s)) * time.Second
+}
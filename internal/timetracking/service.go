@@ -0,0 +1,437 @@
+package timetracking
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+const (
+	// DefaultPageSize is the page size used when none is requested
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page size a caller may request
+	MaxPageSize = 100
+	// MaxNoteLength is the longest time entry note in characters
+	MaxNoteLength = 1000
+	// MaxReportDays is the longest date range a time report covers
+	MaxReportDays = 366
+)
+
+// ErrTimerRunning is returned when starting a timer while another one of
+// the user's timers is still running
+var ErrTimerRunning = errors.New("another timer is already running")
+
+// Service provides the business logic for time tracked on todos
+type Service struct {
+	todos   contract.TodoAccess
+	entries contract.TimeEntryRepository
+	tx      contract.Transactor
+}
+
+// NewService creates a new time tracking service
+func NewService(todos contract.TodoAccess, entries contract.TimeEntryRepository, tx contract.Transactor) *Service {
+	return &Service{todos: todos, entries: entries, tx: tx}
+}
+
+// StartTimerInput represents input for starting a timer on a todo
+type StartTimerInput struct {
+	Note string
+}
+
+// AddEntryInput represents input for entering time spent on a todo by
+// hand. EndedAt must be after StartedAt, which must not be in the future.
+type AddEntryInput struct {
+	StartedAt time.Time
+	EndedAt   time.Time
+	Note      string
+}
+
+// UpdateEntryInput represents input for changing a time entry. Setting
+// EndedAt on a running entry stops its timer.
+type UpdateEntryInput struct {
+	StartedAt *time.Time
+	EndedAt   *time.Time
+	Note      *string
+}
+
+// ListEntriesInput represents input for listing the time entries of a todo
+type ListEntriesInput struct {
+	Page     int
+	PageSize int
+}
+
+// EntryPage represents one page of a todo's time entries. Tracked is
+// the time tracked on the todo over all entries.
+type EntryPage struct {
+	Entries  []entity.TimeEntry
+	Total    int64
+	Page     int
+	PageSize int
+	Tracked  time.Duration
+}
+
+// TotalPages returns the number of pages available for the time entries
+func (p *EntryPage) TotalPages() int {
+	if p.PageSize == 0 {
+		return 0
+	}
+	return int((p.Total + int64(p.PageSize) - 1) / int64(p.PageSize))
+}
+
+// ReportInput represents input for a time report. From and To are
+// calendar days, both inclusive, in Location (UTC when nil); GroupBy is
+// contract.TimeByDay, contract.TimeByProject or contract.TimeByTag.
+type ReportInput struct {
+	From     time.Time
+	To       time.Time
+	GroupBy  string
+	Location *time.Location
+}
+
+// Report represents the time a user tracked over a date range
+type Report struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+	Groups  []contract.TimeTotal
+	Total   time.Duration
+}
+
+// RunningTimer finds the user's running timer
+func (s *Service) RunningTimer(ctx context.Context, userID uint) (*entity.TimeEntry, error) {
+	if userID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var entry *entity.TimeEntry
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		running, err := s.entries.FindRunning(ctx, userID)
+		if err != nil {
+			return err
+		}
+		entry, err = s.entries.FindByID(ctx, running.TodoID, running.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// StartTimer starts a timer on one of the user's todos. A user has at most
+// one running timer; starting another fails with ErrTimerRunning.
+func (s *Service) StartTimer(ctx context.Context, userID, todoID uint, input StartTimerInput) (*entity.TimeEntry, error) {
+	note, ok := timeNote(input.Note)
+	if userID == 0 || todoID == 0 || !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var entry *entity.TimeEntry
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.todos.FindWritable(ctx, userID, todoID); err != nil {
+			return err
+		}
+
+		_, err := s.entries.FindRunning(ctx, userID)
+		if err == nil {
+			return ErrTimerRunning
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return err
+		}
+
+		created := &entity.TimeEntry{
+			TodoID:    todoID,
+			UserID:    userID,
+			StartedAt: time.Now(),
+			Note:      note,
+		}
+		if err := s.entries.Create(ctx, created); err != nil {
+			// A concurrent request started a timer first
+			if errors.Is(err, domain.ErrDuplicateEntry) {
+				return ErrTimerRunning
+			}
+			return err
+		}
+
+		entry, err = s.entries.FindByID(ctx, todoID, created.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// StopTimer stops the user's running timer on a todo
+func (s *Service) StopTimer(ctx context.Context, userID, todoID uint) (*entity.TimeEntry, error) {
+	if userID == 0 || todoID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var entry *entity.TimeEntry
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		running, err := s.entries.FindRunning(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if running.TodoID != todoID {
+			return domain.ErrNotFound
+		}
+
+		now := time.Now()
+		running.EndedAt = &now
+		if err := s.entries.Update(ctx, running); err != nil {
+			return err
+		}
+
+		entry, err = s.entries.FindByID(ctx, todoID, running.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// ListEntries retrieves one page of the time entries of a todo visible to
+// the user, latest first
+func (s *Service) ListEntries(ctx context.Context, userID, todoID uint, input ListEntriesInput) (*EntryPage, error) {
+	if userID == 0 || todoID == 0 || input.Page < 0 || input.PageSize < 0 || input.PageSize > MaxPageSize {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	page := input.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	entries, total, err := s.entries.FindByTodo(ctx, todoID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+	tracked, err := s.entries.TotalByTodo(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntryPage{
+		Entries:  entries,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+		Tracked:  tracked,
+	}, nil
+}
+
+// AddEntry records time the user spent on one of their todos
+func (s *Service) AddEntry(ctx context.Context, userID, todoID uint, input AddEntryInput) (*entity.TimeEntry, error) {
+	note, ok := timeNote(input.Note)
+	if userID == 0 || todoID == 0 || !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	created := &entity.TimeEntry{
+		TodoID:    todoID,
+		UserID:    userID,
+		StartedAt: input.StartedAt,
+		EndedAt:   &input.EndedAt,
+		Note:      note,
+	}
+	if err := validateSpan(created, time.Now()); err != nil {
+		return nil, err
+	}
+
+	var entry *entity.TimeEntry
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.todos.FindWritable(ctx, userID, todoID); err != nil {
+			return err
+		}
+		if err := s.entries.Create(ctx, created); err != nil {
+			return err
+		}
+
+		var err error
+		entry, err = s.entries.FindByID(ctx, todoID, created.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// UpdateEntry changes a time entry on a todo visible to the user. Only the
+// user who tracked the time may change it.
+func (s *Service) UpdateEntry(ctx context.Context, userID, todoID, entryID uint, input UpdateEntryInput) (*entity.TimeEntry, error) {
+	if userID == 0 || todoID == 0 || entryID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var entry *entity.TimeEntry
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+			return err
+		}
+
+		var err error
+		entry, err = s.findOwnEntry(ctx, userID, todoID, entryID)
+		if err != nil {
+			return err
+		}
+
+		if input.StartedAt != nil {
+			entry.StartedAt = *input.StartedAt
+		}
+		if input.EndedAt != nil {
+			entry.EndedAt = input.EndedAt
+		}
+		if input.Note != nil {
+			note, ok := timeNote(*input.Note)
+			if !ok {
+				return domain.ErrInvalidInput
+			}
+			entry.Note = note
+		}
+		if err := validateSpan(entry, time.Now()); err != nil {
+			return err
+		}
+
+		return s.entries.Update(ctx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// DeleteEntry deletes a time entry on a todo visible to the user. Only the
+// user who tracked the time may delete it.
+func (s *Service) DeleteEntry(ctx context.Context, userID, todoID, entryID uint) error {
+	if userID == 0 || todoID == 0 || entryID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.todos.FindVisible(ctx, userID, todoID); err != nil {
+			return err
+		}
+		if _, err := s.findOwnEntry(ctx, userID, todoID, entryID); err != nil {
+			return err
+		}
+		return s.entries.Delete(ctx, todoID, entryID)
+	})
+}
+
+// Report sums the time the user tracked over a range of days, attributing
+// each entry to the day it started on
+func (s *Service) Report(ctx context.Context, userID uint, input ReportInput) (*Report, error) {
+	loc := input.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	from := time.Date(input.From.Year(), input.From.Month(), input.From.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(input.To.Year(), input.To.Month(), input.To.Day(), 0, 0, 0, 0, loc)
+	before := to.AddDate(0, 0, 1)
+
+	switch input.GroupBy {
+	case contract.TimeByDay, contract.TimeByProject, contract.TimeByTag:
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+	if userID == 0 || to.Before(from) || from.AddDate(0, 0, MaxReportDays).Before(before) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	groups, total, err := s.entries.Report(ctx, contract.TimeReportQuery{
+		UserID:   userID,
+		From:     from,
+		Before:   before,
+		GroupBy:  input.GroupBy,
+		Location: loc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		From:    from,
+		To:      to,
+		GroupBy: input.GroupBy,
+		Groups:  groups,
+		Total:   total,
+	}, nil
+}
+
+// findOwnEntry finds a time entry of a todo and checks that the user
+// tracked it
+func (s *Service) findOwnEntry(ctx context.Context, userID, todoID, entryID uint) (*entity.TimeEntry, error) {
+	entry, err := s.entries.FindByID(ctx, todoID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.UserID != userID {
+		return nil, domain.ErrForbidden
+	}
+	return entry, nil
+}
+
+// validateSpan checks that a time entry starts no later than now and, once
+// ended, ends after it started
+func validateSpan(entry *entity.TimeEntry, now time.Time) error {
+	if entry.StartedAt.IsZero() || entry.StartedAt.After(now) {
+		return domain.ErrInvalidInput
+	}
+	if entry.EndedAt != nil && !entry.EndedAt.After(entry.StartedAt) {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+// timeNote trims a time entry note and checks that it is at most
+// MaxNoteLength characters long
+func timeNote(note string) (string, bool) {
+	note = strings.TrimSpace(note)
+	return note, utf8.RuneCountInString(note) <= MaxNoteLength
+}
+
+// IsNotFound checks if error is a not found error
+func IsNotFound(err error) bool {
+	return errors.Is(err, domain.ErrNotFound)
+}
+
+// IsInvalidInput checks if error is an invalid input error
+func IsInvalidInput(err error) bool {
+	return errors.Is(err, domain.ErrInvalidInput)
+}
+
+// IsForbidden checks if error is caused by a project role lacking the
+// permission to change a todo, or by changing another user's time entry
+func IsForbidden(err error) bool {
+	return errors.Is(err, domain.ErrForbidden)
+}
+
+// IsTimerRunning checks if error is caused by starting a timer while
+// another one is running
+func IsTimerRunning(err error) bool {
+	return errors.Is(err, ErrTimerRunning)
+}
@@ -0,0 +1,61 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// Access decides which todos a user may see and change. It implements
+// contract.TodoAccess for the modules that hang data off todos.
+type Access struct {
+	repo    contract.TodoRepository
+	members contract.ProjectMemberRepository
+}
+
+// NewAccess creates a new todo access checker
+func NewAccess(repo contract.TodoRepository, members contract.ProjectMemberRepository) *Access {
+	return &Access{repo: repo, members: members}
+}
+
+// FindVisible finds a todo the user owns or shares a project with
+func (a *Access) FindVisible(ctx context.Context, userID, todoID uint) (*entity.Todo, error) {
+	return a.repo.FindByID(ctx, userID, todoID)
+}
+
+// FindWritable finds a todo visible to the user and checks that they may
+// change it
+func (a *Access) FindWritable(ctx context.Context, userID, todoID uint) (*entity.Todo, error) {
+	todo, err := a.repo.FindByID(ctx, userID, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.CanWrite(ctx, userID, todo); err != nil {
+		return nil, err
+	}
+	return todo, nil
+}
+
+// CanWrite checks that the user may change a todo visible to them. Todos
+// in a project need the editor role; viewers can only read them. Owners
+// keep their own todos in projects they have left.
+func (a *Access) CanWrite(ctx context.Context, userID uint, todo *entity.Todo) error {
+	if todo.ProjectID == nil {
+		return nil
+	}
+
+	member, err := a.members.Find(ctx, *todo.ProjectID, userID)
+	if errors.Is(err, domain.ErrNotFound) && todo.UserID == userID {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !member.Role.Allows(entity.RoleEditor) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func TestFindWritable(t *testing.T) {
+	projectID := uint(1)
+	members := &fakeMembers{roles: map[uint]map[uint]entity.ProjectRole{
+		projectID: {2: entity.RoleOwner, 3: entity.RoleEditor, 4: entity.RoleViewer},
+	}}
+	todos := &fakeTodos{
+		members: members,
+		todos: []entity.Todo{
+			{ID: 1, UserID: 1},
+			{ID: 2, UserID: 1, ProjectID: &projectID},
+		},
+	}
+	access := NewAccess(todos, members)
+
+	tests := []struct {
+		name    string
+		userID  uint
+		todoID  uint
+		wantErr error
+	}{
+		{name: "own todo outside a project", userID: 1, todoID: 1},
+		{name: "own todo in a project they left", userID: 1, todoID: 2},
+		{name: "project owner", userID: 2, todoID: 2},
+		{name: "project editor", userID: 3, todoID: 2},
+		{name: "project viewer", userID: 4, todoID: 2, wantErr: domain.ErrForbidden},
+		{name: "not a member", userID: 5, todoID: 2, wantErr: domain.ErrNotFound},
+		{name: "someone else's todo", userID: 3, todoID: 1, wantErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo, err := access.FindWritable(context.Background(), tt.userID, tt.todoID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("FindWritable() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FindWritable() unexpected error: %v", err)
+			}
+			if todo.ID != tt.todoID {
+				t.Errorf("FindWritable() = todo %d, want %d", todo.ID, tt.todoID)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/pkg/requestid"
+)
+
+// HistoryInput represents input for listing the history of a todo
+type HistoryInput struct {
+	Page     int
+	PageSize int
+}
+
+// TodoEventPage represents one page of a todo's history
+type TodoEventPage struct {
+	Events   []entity.TodoEvent
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+// TotalPages returns the number of pages available for the history
+func (p *TodoEventPage) TotalPages() int {
+	return (&TodoPage{Total: p.Total, PageSize: p.PageSize}).TotalPages()
+}
+
+// todoState is the audited state of a todo. Its JSON field names are the
+// field names used in event changes.
+type todoState struct {
+	Title        string              `json:"title"`
+	Description  string              `json:"description"`
+	Completed    bool                `json:"completed"`
+	Status       string              `json:"status"`
+	Priority     entity.TodoPriority `json:"priority"`
+	DueAt        *time.Time          `json:"due_at"`
+	CompletedAt  *time.Time          `json:"completed_at"`
+	AutoComplete bool                `json:"auto_complete"`
+	Recurrence   string              `json:"recurrence"`
+	Timezone     string              `json:"timezone"`
+	ProjectID    *uint               `json:"project_id"`
+	TagIDs       []uint              `json:"tag_ids"`
+}
+
+// newTodoState captures the audited state of a todo
+func newTodoState(todo *entity.Todo) todoState {
+	tagIDs := make([]uint, 0, len(todo.Tags))
+	for _, tag := range todo.Tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	slices.Sort(tagIDs)
+
+	return todoState{
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Completed:    todo.Completed,
+		Status:       todo.Status,
+		Priority:     todo.Priority,
+		DueAt:        utcTime(todo.DueAt),
+		CompletedAt:  utcTime(todo.CompletedAt),
+		AutoComplete: todo.AutoComplete,
+		Recurrence:   todo.Recurrence,
+		Timezone:     todo.Timezone,
+		ProjectID:    todo.ProjectID,
+		TagIDs:       tagIDs,
+	}
+}
+
+// fields returns the JSON value of each field of the state
+func (st todoState) fields() (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// diffTodos returns the audited fields that differ between two versions of
+// a todo. Every field is reported when before is nil.
+func diffTodos(before, after *entity.Todo) (entity.FieldChanges, error) {
+	afterFields, err := newTodoState(after).fields()
+	if err != nil {
+		return nil, err
+	}
+	beforeFields := make(map[string]json.RawMessage)
+	if before != nil {
+		if beforeFields, err = newTodoState(before).fields(); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make(entity.FieldChanges)
+	for name, value := range afterFields {
+		previous, ok := beforeFields[name]
+		if !ok {
+			previous = json.RawMessage("null")
+		}
+		if !bytes.Equal(previous, value) {
+			changes[name] = entity.FieldChange{Before: previous, After: value}
+		}
+	}
+	return changes, nil
+}
+
+// newTodoEvent builds the event recording a change of todo made by actor,
+// where todo is the state after the change. Field changes are recorded for
+// created, updated and reverted todos; before is nil for created ones. ok
+// is false for updates that changed nothing.
+func newTodoEvent(ctx context.Context, actorID uint, action entity.TodoEventAction, before, todo *entity.Todo) (event entity.TodoEvent, ok bool, err error) {
+	changes := make(entity.FieldChanges)
+	switch action {
+	case entity.TodoCreated, entity.TodoUpdated, entity.TodoReverted:
+		if changes, err = diffTodos(before, todo); err != nil {
+			return entity.TodoEvent{}, false, err
+		}
+	}
+	if action == entity.TodoUpdated && len(changes) == 0 {
+		return entity.TodoEvent{}, false, nil
+	}
+
+	event = entity.TodoEvent{
+		TodoID:    todo.ID,
+		UserID:    todo.UserID,
+		Action:    action,
+		Version:   todo.Version,
+		Changes:   changes,
+		RequestID: requestid.FromContext(ctx),
+	}
+	if actorID != 0 {
+		event.ActorID = &actorID
+	}
+	return event, true, nil
+}
+
+// record appends the event for a change of todo made by actor
+func (s *Service) record(ctx context.Context, actorID uint, action entity.TodoEventAction, before, todo *entity.Todo) error {
+	event, ok, err := newTodoEvent(ctx, actorID, action, before, todo)
+	if err != nil || !ok {
+		return err
+	}
+	return s.events.Create(ctx, &event)
+}
+
+// recordAll appends the events for the same change of several todos made
+// by actor. befores is nil or parallel to todos.
+func (s *Service) recordAll(ctx context.Context, actorID uint, action entity.TodoEventAction, befores []*entity.Todo, todos []entity.Todo) error {
+	events := make([]entity.TodoEvent, 0, len(todos))
+	for i := range todos {
+		var before *entity.Todo
+		if befores != nil {
+			before = befores[i]
+		}
+		event, ok, err := newTodoEvent(ctx, actorID, action, before, &todos[i])
+		if err != nil {
+			return err
+		}
+		if ok {
+			events = append(events, event)
+		}
+	}
+	return s.events.CreateBatch(ctx, events)
+}
+
+// History retrieves one page of the change history of one of the user's
+// todos, newest first
+func (s *Service) History(ctx context.Context, userID, id uint, input HistoryInput) (*TodoEventPage, error) {
+	if userID == 0 || id == 0 || input.Page < 0 || input.PageSize < 0 || input.PageSize > MaxPageSize {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.repo.FindByID(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	page := input.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := pageSizeOrDefault(input.PageSize)
+
+	events, total, err := s.events.FindByTodo(ctx, id, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TodoEventPage{
+		Events:   events,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// Revert restores the fields and tags one of the user's todos had at an
+// earlier revision (version) by undoing the recorded changes made since.
+// Revisions older than the recorded history are reported as not found, and
+// projects and tags deleted since are left out. When version is set the todo is only
+// reverted at that version.
+func (s *Service) Revert(ctx context.Context, userID, id, revision uint, version *uint) (*entity.Todo, error) {
+	if userID == 0 || id == 0 || revision == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var todo *entity.Todo
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		current, err := s.access.FindWritable(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if version != nil && *version != current.Version {
+			return domain.ErrVersionConflict
+		}
+		if revision >= current.Version {
+			return domain.ErrInvalidInput
+		}
+
+		// The history is complete from the version preceding the first event
+		first, err := s.events.FindFirst(ctx, id)
+		if err != nil {
+			return err
+		}
+		if revision+1 < first.Version {
+			return domain.ErrNotFound
+		}
+
+		target, err := s.stateAt(ctx, current, revision)
+		if err != nil {
+			return err
+		}
+
+		todo, err = s.applyState(ctx, userID, current, target)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoReverted, current, todo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// stateAt rebuilds the audited state a todo had at a revision by undoing
+// the changes recorded after it, newest first
+func (s *Service) stateAt(ctx context.Context, todo *entity.Todo, revision uint) (todoState, error) {
+	fields, err := newTodoState(todo).fields()
+	if err != nil {
+		return todoState{}, err
+	}
+
+	events, err := s.events.FindAfter(ctx, todo.ID, revision)
+	if err != nil {
+		return todoState{}, err
+	}
+	for _, event := range events {
+		for name, change := range event.Changes {
+			if _, ok := fields[name]; ok {
+				fields[name] = change.Before
+			}
+		}
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return todoState{}, err
+	}
+	var state todoState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return todoState{}, err
+	}
+	return state, nil
+}
+
+// applyState writes an audited state to a todo, restoring the project and
+// tags that still exist, and returns the updated todo. The status is fitted
+// to the workflow the project has now.
+func (s *Service) applyState(ctx context.Context, userID uint, current *entity.Todo, state todoState) (*entity.Todo, error) {
+	if state.ProjectID != nil {
+		if _, err := s.projects.FindByID(ctx, userID, *state.ProjectID); errors.Is(err, domain.ErrNotFound) {
+			state.ProjectID = nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	workflow, err := s.workflowOf(ctx, state.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	todo := *current
+	todo.Title = state.Title
+	todo.Description = state.Description
+	todo.Completed = state.Completed
+	todo.Priority = state.Priority
+	todo.DueAt = state.DueAt
+	todo.CompletedAt = state.CompletedAt
+	todo.AutoComplete = state.AutoComplete
+	todo.Recurrence = state.Recurrence
+	todo.Timezone = state.Timezone
+	if err := s.moveToList(ctx, &todo, state.ProjectID); err != nil {
+		return nil, err
+	}
+	setStatus(&todo, workflow.Fit(state.Status, state.Completed), time.Now())
+	if err := s.repo.Update(ctx, &todo); err != nil {
+		return nil, err
+	}
+
+	currentIDs := newTodoState(current).TagIDs
+	for _, tagID := range currentIDs {
+		if !slices.Contains(state.TagIDs, tagID) {
+			if err := s.repo.DetachTag(ctx, todo.ID, tagID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	tags, err := s.tags.FindByIDs(ctx, userID, state.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+	var attach []uint
+	for _, tag := range tags {
+		if !slices.Contains(currentIDs, tag.ID) {
+			attach = append(attach, tag.ID)
+		}
+	}
+	if err := s.repo.AttachTags(ctx, todo.ID, attach); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindByID(ctx, userID, todo.ID)
+}
+
+// utcTime returns a copy of t in UTC at the database's microsecond
+// precision, so equal stored instants compare equal
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC().Truncate(time.Microsecond)
+	return &utc
+}
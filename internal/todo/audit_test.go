@@ -0,0 +1,253 @@
+package todo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func (f *fakeTodos) DetachTag(_ context.Context, _, tagID uint) error {
+	f.detached = append(f.detached, tagID)
+	return nil
+}
+
+func (f *fakeEvents) FindAfter(_ context.Context, todoID, version uint) ([]entity.TodoEvent, error) {
+	var found []entity.TodoEvent
+	for _, event := range f.events {
+		if event.TodoID == todoID && event.Version > version {
+			found = append(found, event)
+		}
+	}
+	slices.Reverse(found)
+	return found, nil
+}
+
+func (f *fakeEvents) FindFirst(_ context.Context, todoID uint) (*entity.TodoEvent, error) {
+	for _, event := range f.events {
+		if event.TodoID == todoID {
+			return &event, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+// change builds a field change from JSON values
+func change(before, after string) entity.FieldChange {
+	return entity.FieldChange{Before: json.RawMessage(before), After: json.RawMessage(after)}
+}
+
+func TestDiffTodos(t *testing.T) {
+	due := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	base := entity.Todo{
+		Title:    "Buy milk",
+		Status:   entity.StatusBacklog,
+		Priority: entity.PriorityMedium,
+		DueAt:    &due,
+		Timezone: "UTC",
+		Tags:     []entity.Tag{{ID: 2}, {ID: 1}},
+	}
+
+	tests := []struct {
+		name   string
+		before *entity.Todo
+		after  func(todo entity.Todo) entity.Todo
+		want   []string
+	}{
+		{
+			name: "created todo reports every field that is not null",
+			want: []string{"auto_complete", "completed", "description", "due_at", "priority", "recurrence", "status", "tag_ids", "timezone", "title"},
+		},
+		{
+			name:   "unchanged",
+			before: &base,
+		},
+		{
+			name:   "changed fields",
+			before: &base,
+			after: func(todo entity.Todo) entity.Todo {
+				todo.Title = "Buy eggs"
+				todo.Tags = []entity.Tag{{ID: 3}}
+				return todo
+			},
+			want: []string{"tag_ids", "title"},
+		},
+		{
+			name:   "tag order is ignored",
+			before: &base,
+			after: func(todo entity.Todo) entity.Todo {
+				todo.Tags = []entity.Tag{{ID: 1}, {ID: 2}}
+				return todo
+			},
+		},
+		{
+			name:   "same instant in another zone and below microseconds",
+			before: &base,
+			after: func(todo entity.Todo) entity.Todo {
+				local := due.In(jakarta).Add(time.Nanosecond)
+				todo.DueAt = &local
+				return todo
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := base
+			if tt.after != nil {
+				after = tt.after(base)
+			}
+			changes, err := diffTodos(tt.before, &after)
+			if err != nil {
+				t.Fatalf("diffTodos() unexpected error: %v", err)
+			}
+
+			var got []string
+			for name, change := range changes {
+				if string(change.Before) == string(change.After) {
+					t.Errorf("field %s reported without a change", name)
+				}
+				if tt.before == nil && string(change.Before) != "null" {
+					t.Errorf("field %s of a created todo before = %s, want null", name, change.Before)
+				}
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changed fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRevert(t *testing.T) {
+	version := func(v uint) *uint { return &v }
+
+	tests := []struct {
+		name         string
+		revision     uint
+		version      *uint
+		firstVersion uint
+		wantTitle    string
+		wantAttached []uint
+		wantDetached []uint
+		wantErr      error
+	}{
+		{
+			name:      "previous revision",
+			revision:  2,
+			wantTitle: "Buy eggs",
+		},
+		{
+			name:         "first revision",
+			revision:     1,
+			wantTitle:    "Buy milk",
+			wantDetached: []uint{2},
+		},
+		{
+			name:      "at the current version",
+			revision:  2,
+			version:   version(3),
+			wantTitle: "Buy eggs",
+		},
+		{
+			name:     "at a stale version",
+			revision: 2,
+			version:  version(2),
+			wantErr:  domain.ErrVersionConflict,
+		},
+		{
+			name:     "current revision",
+			revision: 3,
+			wantErr:  domain.ErrInvalidInput,
+		},
+		{
+			name:    "no revision",
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:         "revision older than the history",
+			revision:     1,
+			firstVersion: 3,
+			wantErr:      domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos := &fakeTodos{todos: []entity.Todo{{
+				ID:       1,
+				UserID:   1,
+				Title:    "Buy bread",
+				Status:   entity.StatusInProgress,
+				Priority: entity.PriorityMedium,
+				Version:  3,
+				Tags:     []entity.Tag{{ID: 2, UserID: 1}},
+			}}}
+			events := &fakeEvents{events: []entity.TodoEvent{
+				{TodoID: 1, Version: 1, Action: entity.TodoCreated, Changes: entity.FieldChanges{
+					"title":  change(`null`, `"Buy milk"`),
+					"status": change(`null`, `"backlog"`),
+				}},
+				{TodoID: 1, Version: 2, Action: entity.TodoUpdated, Changes: entity.FieldChanges{
+					"title":   change(`"Buy milk"`, `"Buy eggs"`),
+					"tag_ids": change(`[]`, `[2]`),
+				}},
+				{TodoID: 1, Version: 3, Action: entity.TodoUpdated, Changes: entity.FieldChanges{
+					"title":  change(`"Buy eggs"`, `"Buy bread"`),
+					"status": change(`"backlog"`, `"in_progress"`),
+				}},
+			}}
+			if tt.firstVersion > 0 {
+				events.events = slices.DeleteFunc(events.events, func(event entity.TodoEvent) bool {
+					return event.Version < tt.firstVersion
+				})
+			}
+			recorded := len(events.events)
+			s := NewService(Deps{
+				Todos:      todos,
+				Tags:       &fakeTags{tags: []entity.Tag{{ID: 2, UserID: 1}}},
+				Events:     events,
+				Access:     NewAccess(todos, &fakeMembers{}),
+				Transactor: fakeTx{},
+			})
+
+			todo, err := s.Revert(context.Background(), 1, 1, tt.revision, tt.version)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Revert() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(events.events) != recorded {
+					t.Errorf("Revert() recorded an event on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Revert() unexpected error: %v", err)
+			}
+
+			if todo.Title != tt.wantTitle || todo.Status != entity.StatusBacklog || todo.Version != 4 {
+				t.Errorf("todo = %q/%q at version %d, want %q/%q at version 4", todo.Title, todo.Status, todo.Version, tt.wantTitle, entity.StatusBacklog)
+			}
+			if !reflect.DeepEqual(todos.attached, tt.wantAttached) || !reflect.DeepEqual(todos.detached, tt.wantDetached) {
+				t.Errorf("attached %v and detached %v, want %v and %v", todos.attached, todos.detached, tt.wantAttached, tt.wantDetached)
+			}
+
+			last := events.events[len(events.events)-1]
+			if len(events.events) != recorded+1 || last.Action != entity.TodoReverted || last.Version != 4 {
+				t.Fatalf("recorded %+v, want a reverted event at version 4", last)
+			}
+			if got := last.Changes["title"]; string(got.Before) != `"Buy bread"` {
+				t.Errorf("reverted title change = %s -> %s, want from \"Buy bread\"", got.Before, got.After)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// MaxBulkOperations is the largest number of operations a bulk request may contain
+const MaxBulkOperations = 100
+
+// Bulk operation kinds
+const (
+	BulkCreate   = "create"
+	BulkUpdate   = "update"
+	BulkComplete = "complete"
+	BulkDelete   = "delete"
+)
+
+// ErrBulkAborted is reported for operations of an atomic batch that were
+// not applied because another operation of the batch failed
+var ErrBulkAborted = errors.New("not applied because another operation in the batch failed")
+
+// BulkOperation is one operation of a bulk request. Create operations use
+// Create and update operations use Update; all other kinds address an
+// existing todo by ID, only applying when it is at Version if that is set.
+// Complete operations honour Update.Force like update operations do.
+type BulkOperation struct {
+	Op      string
+	ID      uint
+	Version *uint
+	Create  CreateTodoInput
+	Update  UpdateTodoInput
+}
+
+// BulkResult is the outcome of one bulk operation. Todo holds the
+// resulting todo of successful create, update and complete operations.
+type BulkResult struct {
+	Op   string
+	Todo *entity.Todo
+	Err  error
+}
+
+// bulkWrite is a validated bulk operation ready to be written. before is
+// the current state of the todo an update or delete addresses.
+type bulkWrite struct {
+	index     int
+	todo      *entity.Todo
+	before    *entity.Todo
+	completed bool
+	deleteID  uint
+}
+
+// Bulk runs a batch of operations on the user's todos in one transaction
+// and reports the outcome of each. Atomic batches are all-or-nothing: when
+// an operation fails, the others are reported as ErrBulkAborted, and a
+// failure while writing fails the whole call. Otherwise every valid
+// operation is applied on its own and only failed operations are skipped.
+// A todo may only be addressed by one operation of a batch.
+func (s *Service) Bulk(ctx context.Context, userID uint, ops []BulkOperation, atomic bool) ([]BulkResult, error) {
+	if userID == 0 || len(ops) == 0 || len(ops) > MaxBulkOperations {
+		return nil, domain.ErrInvalidInput
+	}
+
+	results := make([]BulkResult, len(ops))
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		writes, err := s.prepareBulk(ctx, userID, ops, results)
+		if err != nil {
+			return err
+		}
+
+		if atomic {
+			if len(writes) < len(ops) {
+				for i := range results {
+					if results[i].Err == nil {
+						results[i].Err = ErrBulkAborted
+					}
+				}
+				return nil
+			}
+			return s.writeBulk(ctx, userID, writes)
+		}
+
+		for _, write := range writes {
+			err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+				return s.writeBulk(ctx, userID, []bulkWrite{write})
+			})
+			if err != nil {
+				results[write.index].Err = err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		if results[i].Err != nil {
+			results[i].Todo = nil
+		}
+	}
+	return results, nil
+}
+
+// prepareBulk validates the operations against the current todos, loaded
+// with a single query, and records failures in results
+func (s *Service) prepareBulk(ctx context.Context, userID uint, ops []BulkOperation, results []BulkResult) ([]bulkWrite, error) {
+	var ids []uint
+	for _, op := range ops {
+		if op.Op != BulkCreate && op.ID != 0 {
+			ids = append(ids, op.ID)
+		}
+	}
+	todos, err := s.repo.FindByIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[uint]*entity.Todo, len(todos))
+	for i := range todos {
+		current[todos[i].ID] = &todos[i]
+	}
+	denied := make(map[uint]bool)
+	for i := range todos {
+		if err := s.access.CanWrite(ctx, userID, &todos[i]); errors.Is(err, domain.ErrForbidden) {
+			denied[todos[i].ID] = true
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	workflows, err := s.workflowsOf(ctx, todos)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	seen := make(map[uint]bool, len(ids))
+	writes := make([]bulkWrite, 0, len(ops))
+	for i, op := range ops {
+		results[i].Op = op.Op
+		write, err := prepareOperation(userID, op, current, workflows, denied, seen, now)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		write.index = i
+		results[i].Todo = write.todo
+		writes = append(writes, write)
+	}
+	return s.dropBlocked(ctx, ops, writes, results)
+}
+
+// dropBlocked fails the writes completing a todo with open blockers unless
+// their operation forces it, with one query for the whole batch
+func (s *Service) dropBlocked(ctx context.Context, ops []BulkOperation, writes []bulkWrite, results []BulkResult) ([]bulkWrite, error) {
+	var completing []uint
+	for _, write := range writes {
+		if write.completed && !ops[write.index].Update.Force {
+			completing = append(completing, write.todo.ID)
+		}
+	}
+	if len(completing) == 0 {
+		return writes, nil
+	}
+
+	ids, err := s.dependencies.FindBlocked(ctx, completing)
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		blocked[id] = true
+	}
+
+	kept := writes[:0]
+	for _, write := range writes {
+		if write.completed && !ops[write.index].Update.Force && blocked[write.todo.ID] {
+			results[write.index].Err = ErrBlocked
+			continue
+		}
+		kept = append(kept, write)
+	}
+	return kept, nil
+}
+
+// prepareOperation validates one operation and builds its write. workflows
+// holds the workflow of each current todo and denied the todos the user
+// may see but not change.
+func prepareOperation(userID uint, op BulkOperation, current map[uint]*entity.Todo, workflows map[uint]entity.Workflow, denied, seen map[uint]bool, now time.Time) (bulkWrite, error) {
+	if op.Op == BulkCreate {
+		todo, err := newTodo(userID, op.Create)
+		if err != nil {
+			return bulkWrite{}, err
+		}
+		return bulkWrite{todo: todo}, nil
+	}
+
+	switch op.Op {
+	case BulkUpdate, BulkComplete, BulkDelete:
+	default:
+		return bulkWrite{}, fmt.Errorf("%w: unknown operation %q", domain.ErrInvalidInput, op.Op)
+	}
+	if op.ID == 0 {
+		return bulkWrite{}, domain.ErrInvalidInput
+	}
+	if seen[op.ID] {
+		return bulkWrite{}, fmt.Errorf("%w: todo %d is addressed more than once", domain.ErrInvalidInput, op.ID)
+	}
+	seen[op.ID] = true
+
+	existing, ok := current[op.ID]
+	if !ok {
+		return bulkWrite{}, domain.ErrNotFound
+	}
+	if denied[op.ID] {
+		return bulkWrite{}, domain.ErrForbidden
+	}
+	if op.Version != nil && *op.Version != existing.Version {
+		return bulkWrite{}, domain.ErrVersionConflict
+	}
+
+	if op.Op == BulkDelete {
+		return bulkWrite{deleteID: op.ID, before: existing}, nil
+	}
+
+	input := op.Update
+	if op.Op == BulkComplete {
+		completed := true
+		input = UpdateTodoInput{Completed: &completed, Force: op.Update.Force}
+	}
+
+	todo := *existing
+	if err := applyUpdate(&todo, input, workflows[op.ID], now); err != nil {
+		return bulkWrite{}, err
+	}
+	return bulkWrite{todo: &todo, before: existing, completed: todo.Completed && !existing.Completed}, nil
+}
+
+// writeBulk writes prepared operations with one batch call per kind
+func (s *Service) writeBulk(ctx context.Context, userID uint, writes []bulkWrite) error {
+	var creates, updates, deleted []entity.Todo
+	var befores []*entity.Todo
+	var deletes []uint
+	for _, write := range writes {
+		switch {
+		case write.deleteID != 0:
+			deletes = append(deletes, write.deleteID)
+			deleted = append(deleted, *write.before)
+		case write.todo.ID == 0:
+			creates = append(creates, *write.todo)
+		default:
+			if write.completed {
+				if err := s.scheduleNext(ctx, userID, write.todo); err != nil {
+					return err
+				}
+			}
+			updates = append(updates, *write.todo)
+			befores = append(befores, write.before)
+		}
+	}
+
+	if err := s.repo.CreateBatch(ctx, creates); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateBatch(ctx, updates); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteBatch(ctx, userID, deletes); err != nil {
+		return err
+	}
+
+	if err := s.recordAll(ctx, userID, entity.TodoCreated, nil, creates); err != nil {
+		return err
+	}
+	if err := s.recordAll(ctx, userID, entity.TodoUpdated, befores, updates); err != nil {
+		return err
+	}
+	if err := s.recordAll(ctx, userID, entity.TodoDeleted, nil, deleted); err != nil {
+		return err
+	}
+
+	// Copy generated IDs and advanced versions back to the results
+	created, updated := 0, 0
+	for _, write := range writes {
+		switch {
+		case write.deleteID != 0:
+		case write.todo.ID == 0:
+			*write.todo = creates[created]
+			created++
+		default:
+			*write.todo = updates[updated]
+			updated++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (f *fakeTodos) FindByIDs(_ context.Context, userID uint, ids []uint) ([]entity.Todo, error) {
+	var found []entity.Todo
+	for _, id := range ids {
+		if todo, err := f.find(userID, id); err == nil {
+			found = append(found, *todo)
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeTodos) CreateBatch(_ context.Context, todos []entity.Todo) error {
+	for i := range todos {
+		todos[i].ID = uint(100 + len(f.todos))
+		todos[i].Version = 1
+		f.todos = append(f.todos, todos[i])
+	}
+	return nil
+}
+
+func (f *fakeTodos) UpdateBatch(ctx context.Context, todos []entity.Todo) error {
+	for i := range todos {
+		if err := f.Update(ctx, &todos[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeTodos) DeleteBatch(_ context.Context, userID uint, ids []uint) error {
+	for _, id := range ids {
+		todo, err := f.find(userID, id)
+		if err != nil {
+			return err
+		}
+		todo.DeletedAt = gorm.DeletedAt{Valid: true}
+	}
+	return nil
+}
+
+// fakeDependencies holds links between todos and reports the listed todos
+// as blocked
+type fakeDependencies struct {
+	contract.TodoDependencyRepository
+	links   []entity.TodoDependency
+	blocked []uint
+	locked  bool
+}
+
+func (f *fakeDependencies) FindBlocked(_ context.Context, todoIDs []uint) ([]uint, error) {
+	var blocked []uint
+	for _, id := range todoIDs {
+		if slices.Contains(f.blocked, id) {
+			blocked = append(blocked, id)
+		}
+	}
+	return blocked, nil
+}
+
+func TestBulk(t *testing.T) {
+	projectID := uint(3)
+	version := func(v uint) *uint { return &v }
+	title := "Renamed"
+
+	tests := []struct {
+		name      string
+		ops       []BulkOperation
+		atomic    bool
+		wantErrs  []error
+		wantTitle string
+		wantErr   bool
+	}{
+		{
+			name: "every kind",
+			ops: []BulkOperation{
+				{Op: BulkCreate, Create: CreateTodoInput{Title: "New"}},
+				{Op: BulkUpdate, ID: 10, Version: version(1), Update: UpdateTodoInput{Title: &title}},
+				{Op: BulkComplete, ID: 12},
+				{Op: BulkDelete, ID: 11},
+			},
+			atomic:    true,
+			wantErrs:  []error{nil, nil, nil, nil},
+			wantTitle: title,
+		},
+		{
+			name: "invalid operations",
+			ops: []BulkOperation{
+				{Op: "archive", ID: 10},
+				{Op: BulkUpdate},
+				{Op: BulkCreate},
+				{Op: BulkDelete, ID: 99},
+			},
+			wantErrs: []error{domain.ErrInvalidInput, domain.ErrInvalidInput, domain.ErrInvalidInput, domain.ErrNotFound},
+		},
+		{
+			name: "todo addressed twice",
+			ops: []BulkOperation{
+				{Op: BulkUpdate, ID: 10, Update: UpdateTodoInput{Title: &title}},
+				{Op: BulkDelete, ID: 10},
+			},
+			wantErrs:  []error{nil, domain.ErrInvalidInput},
+			wantTitle: title,
+		},
+		{
+			name: "stale version",
+			ops: []BulkOperation{
+				{Op: BulkDelete, ID: 10, Version: version(2)},
+			},
+			wantErrs: []error{domain.ErrVersionConflict},
+		},
+		{
+			name: "todo the user may only read",
+			ops: []BulkOperation{
+				{Op: BulkComplete, ID: 13},
+			},
+			wantErrs: []error{domain.ErrForbidden},
+		},
+		{
+			name: "completing against the workflow",
+			ops: []BulkOperation{
+				{Op: BulkComplete, ID: 10},
+			},
+			wantErrs: []error{ErrTransitionNotAllowed},
+		},
+		{
+			name: "completing a blocked todo",
+			ops: []BulkOperation{
+				{Op: BulkComplete, ID: 14},
+				{Op: BulkComplete, ID: 12},
+			},
+			wantErrs: []error{ErrBlocked, nil},
+		},
+		{
+			name: "forcing a blocked todo",
+			ops: []BulkOperation{
+				{Op: BulkComplete, ID: 14, Update: UpdateTodoInput{Force: true}},
+			},
+			wantErrs: []error{nil},
+		},
+		{
+			name: "atomic batch with a failure",
+			ops: []BulkOperation{
+				{Op: BulkUpdate, ID: 10, Update: UpdateTodoInput{Title: &title}},
+				{Op: BulkDelete, ID: 99},
+			},
+			atomic:   true,
+			wantErrs: []error{ErrBulkAborted, domain.ErrNotFound},
+		},
+		{
+			name:    "no operations",
+			wantErr: true,
+		},
+		{
+			name:    "too many operations",
+			ops:     make([]BulkOperation, MaxBulkOperations+1),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := &fakeMembers{roles: map[uint]map[uint]entity.ProjectRole{
+				projectID: {1: entity.RoleViewer, 2: entity.RoleOwner},
+			}}
+			todos := &fakeTodos{
+				todos: []entity.Todo{
+					{ID: 10, UserID: 1, Title: "Own", Status: entity.StatusBacklog, Version: 1},
+					{ID: 11, UserID: 1, Title: "Trash me", Status: entity.StatusBacklog, Version: 1},
+					{ID: 12, UserID: 1, Title: "Reviewed", Status: entity.StatusReview, Version: 1},
+					{ID: 13, UserID: 2, Title: "Shared", Status: entity.StatusReview, ProjectID: &projectID, Version: 1},
+					{ID: 14, UserID: 1, Title: "Blocked", Status: entity.StatusReview, Version: 1},
+				},
+				members: members,
+			}
+			events := &fakeEvents{}
+			s := NewService(Deps{
+				Todos:        todos,
+				Events:       events,
+				Dependencies: &fakeDependencies{blocked: []uint{14}},
+				Projects:     &fakeProjects{},
+				Members:      members,
+				Access:       NewAccess(todos, members),
+				Transactor:   fakeTx{},
+			})
+
+			results, err := s.Bulk(context.Background(), 1, tt.ops, tt.atomic)
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("Bulk() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Bulk() unexpected error: %v", err)
+			}
+
+			applied := 0
+			for i, result := range results {
+				if !errors.Is(result.Err, tt.wantErrs[i]) || (tt.wantErrs[i] == nil && result.Err != nil) {
+					t.Errorf("operation %d error = %v, want %v", i, result.Err, tt.wantErrs[i])
+				}
+				if result.Op != tt.ops[i].Op {
+					t.Errorf("operation %d op = %q, want %q", i, result.Op, tt.ops[i].Op)
+				}
+				if result.Err != nil && result.Todo != nil {
+					t.Errorf("operation %d failed but returned a todo", i)
+				}
+				if result.Err == nil {
+					applied++
+				}
+			}
+			if len(events.events) != applied {
+				t.Errorf("recorded %d events, want %d", len(events.events), applied)
+			}
+
+			wantTitle := tt.wantTitle
+			if wantTitle == "" {
+				wantTitle = "Own"
+			}
+			if todos.todos[0].Title != wantTitle {
+				t.Errorf("todo 10 title = %q, want %q", todos.todos[0].Title, wantTitle)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// AddItemInput represents input for adding a checklist item to a todo
+type AddItemInput struct {
+	Title string
+}
+
+// UpdateItemInput represents input for updating a checklist item
+type UpdateItemInput struct {
+	Title     *string
+	Completed *bool
+}
+
+// ListItems retrieves the checklist items of one of the user's todos in order
+func (s *Service) ListItems(ctx context.Context, userID, todoID uint) ([]entity.ChecklistItem, error) {
+	if userID == 0 || todoID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.repo.FindByID(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	return s.items.FindByTodo(ctx, todoID)
+}
+
+// AddItem appends a new open checklist item to one of the user's todos
+func (s *Service) AddItem(ctx context.Context, userID, todoID uint, input AddItemInput) (*entity.ChecklistItem, error) {
+	title := strings.TrimSpace(input.Title)
+	if userID == 0 || todoID == 0 || title == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var item *entity.ChecklistItem
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.access.FindWritable(ctx, userID, todoID); err != nil {
+			return err
+		}
+
+		items, err := s.items.FindByTodo(ctx, todoID)
+		if err != nil {
+			return err
+		}
+
+		position := 0
+		if len(items) > 0 {
+			position = items[len(items)-1].Position + 1
+		}
+
+		item = &entity.ChecklistItem{
+			TodoID:   todoID,
+			Title:    title,
+			Position: position,
+		}
+		if err := s.items.Create(ctx, item); err != nil {
+			return err
+		}
+		return s.repo.Touch(ctx, todoID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// UpdateItem updates a checklist item of one of the user's todos.
+// Completing or reopening an item keeps an auto-completing parent in sync.
+func (s *Service) UpdateItem(ctx context.Context, userID, todoID, itemID uint, input UpdateItemInput) (*entity.ChecklistItem, error) {
+	if userID == 0 || todoID == 0 || itemID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	if input.Title != nil && strings.TrimSpace(*input.Title) == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var item *entity.ChecklistItem
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		todo, err := s.access.FindWritable(ctx, userID, todoID)
+		if err != nil {
+			return err
+		}
+
+		item, err = s.items.FindByID(ctx, todoID, itemID)
+		if err != nil {
+			return err
+		}
+
+		// Update fields if provided
+		if input.Title != nil {
+			item.Title = strings.TrimSpace(*input.Title)
+		}
+		reopened := false
+		if input.Completed != nil && *input.Completed != item.Completed {
+			item.Completed = *input.Completed
+			if item.Completed {
+				now := time.Now()
+				item.CompletedAt = &now
+			} else {
+				item.CompletedAt = nil
+				reopened = true
+			}
+		}
+
+		if err := s.items.Update(ctx, item); err != nil {
+			return err
+		}
+
+		if err := s.syncParent(ctx, userID, todo, reopened); err != nil {
+			return err
+		}
+		return s.repo.Touch(ctx, todoID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// DeleteItem removes a checklist item from one of the user's todos.
+// Removing the last open item completes an auto-completing parent.
+func (s *Service) DeleteItem(ctx context.Context, userID, todoID, itemID uint) error {
+	if userID == 0 || todoID == 0 || itemID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		todo, err := s.access.FindWritable(ctx, userID, todoID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.items.Delete(ctx, todoID, itemID); err != nil {
+			return err
+		}
+
+		if err := s.syncParent(ctx, userID, todo, false); err != nil {
+			return err
+		}
+		return s.repo.Touch(ctx, todoID)
+	})
+}
+
+// ReorderItems reorders the checklist of one of the user's todos.
+// itemIDs must list every item of the todo exactly once, in the new order.
+func (s *Service) ReorderItems(ctx context.Context, userID, todoID uint, itemIDs []uint) ([]entity.ChecklistItem, error) {
+	if userID == 0 || todoID == 0 || len(itemIDs) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var items []entity.ChecklistItem
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.access.FindWritable(ctx, userID, todoID); err != nil {
+			return err
+		}
+
+		current, err := s.items.FindByTodo(ctx, todoID)
+		if err != nil {
+			return err
+		}
+		if !samePermutation(current, itemIDs) {
+			return domain.ErrInvalidInput
+		}
+
+		if err := s.items.Reorder(ctx, todoID, itemIDs); err != nil {
+			return err
+		}
+		if err := s.repo.Touch(ctx, todoID); err != nil {
+			return err
+		}
+
+		items, err = s.items.FindByTodo(ctx, todoID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// syncParent completes an auto-completing todo once all of its items are
+// done, and reopens it when one of its items was reopened. Todos without
+// items are left alone, and so are todos with open blockers or whose
+// workflow does not allow the move. Completing a recurring todo this way
+// also creates its next occurrence.
+func (s *Service) syncParent(ctx context.Context, userID uint, todo *entity.Todo, itemReopened bool) error {
+	if !todo.AutoComplete {
+		return nil
+	}
+
+	items, err := s.items.FindByTodo(ctx, todo.ID)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	allDone := !slices.ContainsFunc(items, func(item entity.ChecklistItem) bool {
+		return !item.Completed
+	})
+
+	var completed bool
+	switch {
+	case allDone && !todo.Completed:
+		// A blocked todo stays open until its blockers are done
+		blocked, err := s.dependencies.FindBlocked(ctx, []uint{todo.ID})
+		if err != nil || len(blocked) > 0 {
+			return err
+		}
+		completed = true
+	case itemReopened && todo.Completed:
+		completed = false
+	default:
+		return nil
+	}
+
+	workflow, err := s.workflowOf(ctx, todo.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	before := *todo
+	if err := applyUpdate(todo, UpdateTodoInput{Completed: &completed}, workflow, time.Now()); err != nil {
+		if errors.Is(err, ErrTransitionNotAllowed) {
+			return nil
+		}
+		return err
+	}
+	if completed {
+		if err := s.scheduleNext(ctx, userID, todo); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.Update(ctx, todo); err != nil {
+		return err
+	}
+	return s.record(ctx, userID, entity.TodoUpdated, &before, todo)
+}
+
+// samePermutation reports whether ids lists every item exactly once
+func samePermutation(items []entity.ChecklistItem, ids []uint) bool {
+	if len(items) != len(ids) {
+		return false
+	}
+
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	for _, item := range items {
+		if !seen[item.ID] {
+			return false
+		}
+	}
+	return len(seen) == len(items)
+}
@@ -0,0 +1,148 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// MaxGraphLinks is the largest number of links a dependency graph holds
+const MaxGraphLinks = 500
+
+var (
+	// ErrDependencyCycle is returned when a link would make a todo
+	// transitively block itself
+	ErrDependencyCycle = errors.New("the link would create a dependency cycle")
+
+	// ErrBlocked is returned when completing a todo whose blockers are
+	// still open without forcing it
+	ErrBlocked = errors.New("the todo is blocked by open todos")
+)
+
+// DependencyGraph holds the todos a todo is transitively blocked by or
+// transitively blocks, the todo itself included, and the links between
+// them. Todos the user cannot see are left out together with their links.
+// Blocked marks the todos that have open blockers.
+type DependencyGraph struct {
+	TodoID  uint
+	Todos   []entity.Todo
+	Links   []entity.TodoDependency
+	Blocked map[uint]bool
+}
+
+// AddBlocker records that one of the user's todos is blocked by another
+// todo visible to them. Links that would create a cycle are rejected with
+// ErrDependencyCycle; existing links are kept as they are.
+func (s *Service) AddBlocker(ctx context.Context, userID, todoID, blockerID uint) error {
+	if userID == 0 || todoID == 0 || blockerID == 0 || todoID == blockerID {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.access.FindWritable(ctx, userID, todoID); err != nil {
+			return err
+		}
+		if _, err := s.repo.FindByID(ctx, userID, blockerID); err != nil {
+			return err
+		}
+
+		if err := s.dependencies.LockGraph(ctx); err != nil {
+			return err
+		}
+		cycle, err := s.dependencies.DependsOn(ctx, blockerID, todoID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return ErrDependencyCycle
+		}
+		return s.dependencies.Create(ctx, todoID, blockerID)
+	})
+}
+
+// RemoveBlocker removes the link between one of the user's todos and one
+// of its blockers
+func (s *Service) RemoveBlocker(ctx context.Context, userID, todoID, blockerID uint) error {
+	if userID == 0 || todoID == 0 || blockerID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.access.FindWritable(ctx, userID, todoID); err != nil {
+			return err
+		}
+		return s.dependencies.Delete(ctx, todoID, blockerID)
+	})
+}
+
+// Dependencies retrieves the dependency graph around a todo visible to the
+// user, up to MaxGraphLinks links
+func (s *Service) Dependencies(ctx context.Context, userID, todoID uint) (*DependencyGraph, error) {
+	if userID == 0 || todoID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := s.repo.FindByID(ctx, userID, todoID); err != nil {
+		return nil, err
+	}
+
+	links, err := s.dependencies.FindGraph(ctx, todoID, MaxGraphLinks)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []uint{todoID}
+	seen := map[uint]bool{todoID: true}
+	for _, link := range links {
+		for _, id := range []uint{link.TodoID, link.BlockerID} {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	todos, err := s.repo.FindByIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	visible := make(map[uint]bool, len(todos))
+	for _, todo := range todos {
+		visible[todo.ID] = true
+	}
+
+	graph := &DependencyGraph{
+		TodoID:  todoID,
+		Todos:   todos,
+		Links:   make([]entity.TodoDependency, 0, len(links)),
+		Blocked: make(map[uint]bool),
+	}
+	for _, link := range links {
+		if visible[link.TodoID] && visible[link.BlockerID] {
+			graph.Links = append(graph.Links, link)
+		}
+	}
+
+	blocked, err := s.dependencies.FindBlocked(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range blocked {
+		graph.Blocked[id] = true
+	}
+	return graph, nil
+}
+
+// checkUnblocked fails with ErrBlocked when a todo has open blockers
+func (s *Service) checkUnblocked(ctx context.Context, todoID uint) error {
+	blocked, err := s.dependencies.FindBlocked(ctx, []uint{todoID})
+	if err != nil {
+		return err
+	}
+	if len(blocked) > 0 {
+		return ErrBlocked
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func (f *fakeDependencies) LockGraph(_ context.Context) error {
+	f.locked = true
+	return nil
+}
+
+// DependsOn follows the links from the todo to its blockers, depth first
+func (f *fakeDependencies) DependsOn(_ context.Context, todoID, blockerID uint) (bool, error) {
+	seen := map[uint]bool{}
+	stack := []uint{todoID}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, link := range f.links {
+			if link.TodoID != id || seen[link.BlockerID] {
+				continue
+			}
+			if link.BlockerID == blockerID {
+				return true, nil
+			}
+			seen[link.BlockerID] = true
+			stack = append(stack, link.BlockerID)
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeDependencies) Create(_ context.Context, todoID, blockerID uint) error {
+	link := entity.TodoDependency{TodoID: todoID, BlockerID: blockerID}
+	if !slices.Contains(f.links, link) {
+		f.links = append(f.links, link)
+	}
+	return nil
+}
+
+func (f *fakeDependencies) FindGraph(_ context.Context, _ uint, limit int) ([]entity.TodoDependency, error) {
+	return f.links[:min(limit, len(f.links))], nil
+}
+
+// dependencyFixture returns todos 1 to 4 of user 1, where 1 is blocked by
+// 2 and 2 by 3, todo 5 of user 2 and a project todo 6 user 1 can only view
+func dependencyFixture() (*fakeTodos, *fakeDependencies, *Service) {
+	projectID := uint(1)
+	members := &fakeMembers{roles: map[uint]map[uint]entity.ProjectRole{projectID: {1: entity.RoleViewer}}}
+	todos := &fakeTodos{
+		members: members,
+		todos: []entity.Todo{
+			{ID: 1, UserID: 1}, {ID: 2, UserID: 1}, {ID: 3, UserID: 1}, {ID: 4, UserID: 1},
+			{ID: 5, UserID: 2}, {ID: 6, UserID: 2, ProjectID: &projectID},
+		},
+	}
+	dependencies := &fakeDependencies{links: []entity.TodoDependency{
+		{TodoID: 1, BlockerID: 2},
+		{TodoID: 2, BlockerID: 3},
+	}}
+	s := NewService(Deps{
+		Todos:        todos,
+		Dependencies: dependencies,
+		Access:       NewAccess(todos, members),
+		Transactor:   fakeTx{},
+	})
+	return todos, dependencies, s
+}
+
+func TestAddBlocker(t *testing.T) {
+	tests := []struct {
+		name      string
+		todoID    uint
+		blockerID uint
+		wantErr   error
+	}{
+		{name: "new link", todoID: 3, blockerID: 4},
+		{name: "existing link", todoID: 1, blockerID: 2},
+		{name: "shortcut along a chain", todoID: 1, blockerID: 3},
+		{name: "blocker visible through a project", todoID: 4, blockerID: 6},
+		{name: "direct cycle", todoID: 2, blockerID: 1, wantErr: ErrDependencyCycle},
+		{name: "transitive cycle", todoID: 3, blockerID: 1, wantErr: ErrDependencyCycle},
+		{name: "itself", todoID: 1, blockerID: 1, wantErr: domain.ErrInvalidInput},
+		{name: "blocker not visible", todoID: 1, blockerID: 5, wantErr: domain.ErrNotFound},
+		{name: "todo not writable", todoID: 6, blockerID: 1, wantErr: domain.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, dependencies, s := dependencyFixture()
+			links := len(dependencies.links)
+
+			err := s.AddBlocker(context.Background(), 1, tt.todoID, tt.blockerID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("AddBlocker() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(dependencies.links) != links {
+					t.Errorf("AddBlocker() added a link on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddBlocker() unexpected error: %v", err)
+			}
+			if !dependencies.locked {
+				t.Error("AddBlocker() checked for cycles without locking the graph")
+			}
+			if !slices.Contains(dependencies.links, entity.TodoDependency{TodoID: tt.todoID, BlockerID: tt.blockerID}) {
+				t.Errorf("links = %v, want %d blocked by %d", dependencies.links, tt.todoID, tt.blockerID)
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	_, dependencies, s := dependencyFixture()
+	dependencies.links = append(dependencies.links, entity.TodoDependency{TodoID: 3, BlockerID: 5})
+	dependencies.blocked = []uint{1, 2, 3}
+
+	graph, err := s.Dependencies(context.Background(), 1, 1)
+	if err != nil {
+		t.Fatalf("Dependencies() unexpected error: %v", err)
+	}
+
+	var ids []uint
+	for _, todo := range graph.Todos {
+		ids = append(ids, todo.ID)
+	}
+	if want := []uint{1, 2, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("todos = %v, want %v", ids, want)
+	}
+	if want := dependencies.links[:2]; !reflect.DeepEqual(graph.Links, want) {
+		t.Errorf("links = %v, want %v without the hidden todo", graph.Links, want)
+	}
+	if want := map[uint]bool{1: true, 2: true, 3: true}; !reflect.DeepEqual(graph.Blocked, want) {
+		t.Errorf("blocked = %v, want %v", graph.Blocked, want)
+	}
+
+	if _, err := s.Dependencies(context.Background(), 1, 5); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Dependencies() of a hidden todo error = %v, want ErrNotFound", err)
+	}
+}
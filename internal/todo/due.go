@@ -0,0 +1,54 @@
+package todo
+
+import (
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+)
+
+// Due date windows accepted by ListTodosInput.Due
+const (
+	DueOverdue  = "overdue"
+	DueToday    = "today"
+	DueThisWeek = "week"
+)
+
+// applyDueWindow narrows the filter to a due date window evaluated in loc.
+// Days start at local midnight and weeks start on Monday, so "today" for a
+// user in Asia/Jakarta differs from "today" in UTC. Overdue todos are open
+// todos whose due date has already passed.
+func applyDueWindow(filter *contract.TodoFilter, window string, loc *time.Location, now time.Time) error {
+	if loc == nil {
+		loc = time.UTC
+	}
+	now = now.In(loc)
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	var from, before time.Time
+	switch window {
+	case "":
+		return nil
+	case DueOverdue:
+		if filter.Completed != nil && *filter.Completed {
+			return domain.ErrInvalidInput
+		}
+		open := false
+		filter.Completed = &open
+		filter.DueBefore = &now
+		return nil
+	case DueToday:
+		from = startOfDay
+		before = startOfDay.AddDate(0, 0, 1)
+	case DueThisWeek:
+		daysSinceMonday := (int(now.Weekday()) + 6) % 7
+		from = startOfDay.AddDate(0, 0, -daysSinceMonday)
+		before = from.AddDate(0, 0, 7)
+	default:
+		return domain.ErrInvalidInput
+	}
+
+	filter.DueFrom = &from
+	filter.DueBefore = &before
+	return nil
+}
@@ -0,0 +1,147 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+)
+
+func TestApplyDueWindow(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+	// Wednesday 2026-03-04 20:00 UTC is already Thursday 03:00 in Jakarta
+	now := time.Date(2026, 3, 4, 20, 0, 0, 0, time.UTC)
+	completed, open := true, false
+
+	tests := []struct {
+		name          string
+		window        string
+		loc           *time.Location
+		completed     *bool
+		wantFrom      *time.Time
+		wantBefore    *time.Time
+		wantCompleted *bool
+		wantErr       bool
+	}{
+		{
+			name: "no window",
+		},
+		{
+			name:       "today in UTC",
+			window:     DueToday,
+			wantFrom:   ptr(time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)),
+			wantBefore: ptr(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:       "today in the user's timezone",
+			window:     DueToday,
+			loc:        jakarta,
+			wantFrom:   ptr(time.Date(2026, 3, 5, 0, 0, 0, 0, jakarta)),
+			wantBefore: ptr(time.Date(2026, 3, 6, 0, 0, 0, 0, jakarta)),
+		},
+		{
+			name:       "week starts on Monday",
+			window:     DueThisWeek,
+			wantFrom:   ptr(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)),
+			wantBefore: ptr(time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:       "week in the user's timezone",
+			window:     DueThisWeek,
+			loc:        jakarta,
+			wantFrom:   ptr(time.Date(2026, 3, 2, 0, 0, 0, 0, jakarta)),
+			wantBefore: ptr(time.Date(2026, 3, 9, 0, 0, 0, 0, jakarta)),
+		},
+		{
+			name:          "overdue keeps open todos due before now",
+			window:        DueOverdue,
+			loc:           jakarta,
+			wantBefore:    &now,
+			wantCompleted: &open,
+		},
+		{
+			name:          "overdue with open todos",
+			window:        DueOverdue,
+			completed:     &open,
+			wantBefore:    &now,
+			wantCompleted: &open,
+		},
+		{
+			name:      "overdue with completed todos",
+			window:    DueOverdue,
+			completed: &completed,
+			wantErr:   true,
+		},
+		{
+			name:    "unknown window",
+			window:  "tomorrow",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := contract.TodoFilter{Completed: tt.completed}
+			err := applyDueWindow(&filter, tt.window, tt.loc, now)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidInput) {
+					t.Fatalf("applyDueWindow() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyDueWindow() unexpected error: %v", err)
+			}
+			if !sameInstant(filter.DueFrom, tt.wantFrom) || !sameInstant(filter.DueBefore, tt.wantBefore) {
+				t.Errorf("due window = [%v, %v), want [%v, %v)", filter.DueFrom, filter.DueBefore, tt.wantFrom, tt.wantBefore)
+			}
+			if (filter.Completed == nil) != (tt.wantCompleted == nil) ||
+				(filter.Completed != nil && *filter.Completed != *tt.wantCompleted) {
+				t.Errorf("completed = %v, want %v", filter.Completed, tt.wantCompleted)
+			}
+		})
+	}
+}
+
+func TestGetAllDueInLocation(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+	todos := &fakeTodos{}
+	s := NewService(Deps{Todos: todos})
+
+	if _, err := s.GetAll(context.Background(), 1, ListTodosInput{Due: DueToday, Location: jakarta}); err != nil {
+		t.Fatalf("GetAll() unexpected error: %v", err)
+	}
+
+	from, before := todos.query.DueFrom, todos.query.DueBefore
+	if from == nil || before == nil {
+		t.Fatalf("due window = [%v, %v), want both bounds", from, before)
+	}
+	if local := from.In(jakarta); local.Hour() != 0 || local.Minute() != 0 || local.Second() != 0 {
+		t.Errorf("window starts at %v, want midnight in Asia/Jakarta", local)
+	}
+	if got := before.Sub(*from); got != 24*time.Hour {
+		t.Errorf("window lasts %v, want 24h", got)
+	}
+}
+
+// ptr returns a pointer to a copy of v
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// sameInstant reports whether two optional times are both unset or the same instant
+func sameInstant(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,27 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// ExportBatchSize is the number of todos read per query while exporting
+const ExportBatchSize = 500
+
+// Export streams the user's todos matching the listing filters to fn in
+// batches, oldest first, without loading them all at once. Paging and sort
+// options of the input are not supported.
+func (s *Service) Export(ctx context.Context, userID uint, input ListTodosInput, fn func([]entity.Todo) error) error {
+	if input.Page != 0 || input.PageSize != 0 || input.SortBy != "" || input.SortOrder != "" {
+		return domain.ErrInvalidInput
+	}
+
+	filter, err := listFilter(userID, input)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.FindInBatches(ctx, filter, ExportBatchSize, fn)
+}
@@ -0,0 +1,68 @@
+package todo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// FindInBatches records the filter and passes the todos to fn in batches
+func (f *fakeTodos) FindInBatches(_ context.Context, filter contract.TodoFilter, batchSize int, fn func([]entity.Todo) error) error {
+	f.query = contract.TodoQuery{TodoFilter: filter, Limit: batchSize}
+	for start := 0; start < len(f.todos); start += batchSize {
+		if err := fn(f.todos[start:min(start+batchSize, len(f.todos))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	completed := false
+
+	tests := []struct {
+		name        string
+		input       ListTodosInput
+		wantBatches int
+		wantErr     bool
+	}{
+		{name: "every todo", wantBatches: 3},
+		{name: "filtered", input: ListTodosInput{Completed: &completed, Tags: []string{"work"}}, wantBatches: 3},
+		{name: "page", input: ListTodosInput{Page: 1}, wantErr: true},
+		{name: "page size", input: ListTodosInput{PageSize: 10}, wantErr: true},
+		{name: "sort", input: ListTodosInput{SortBy: "title"}, wantErr: true},
+		{name: "order", input: ListTodosInput{SortOrder: "asc"}, wantErr: true},
+		{name: "invalid filter", input: ListTodosInput{Due: "someday"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos := &fakeTodos{todos: make([]entity.Todo, 2*ExportBatchSize+1)}
+			s := NewService(Deps{Todos: todos})
+
+			var batches, exported int
+			err := s.Export(context.Background(), 1, tt.input, func(batch []entity.Todo) error {
+				batches++
+				exported += len(batch)
+				return nil
+			})
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("Export() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Export() unexpected error: %v", err)
+			}
+			if batches != tt.wantBatches || exported != len(todos.todos) {
+				t.Errorf("exported %d todos in %d batches, want %d in %d", exported, batches, len(todos.todos), tt.wantBatches)
+			}
+			if todos.query.UserID != 1 || todos.query.Completed != tt.input.Completed || len(todos.query.Tags) != len(tt.input.Tags) {
+				t.Errorf("filter = %+v, want the user's filter", todos.query.TodoFilter)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Bulk handles POST /api/v1/todos/bulk.
+// Responds with 200 when every operation succeeded and 207 otherwise.
+// force=true lets update and complete operations complete blocked todos.
+func (h *Handler) Bulk(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req BulkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	options, ok := writeOptions(c)
+	if !ok {
+		return
+	}
+
+	ops := make([]todo.BulkOperation, 0, len(req.Operations))
+	for _, op := range req.Operations {
+		operation := newBulkOperation(op)
+		operation.Update.Force = options.Force
+		ops = append(ops, operation)
+	}
+
+	results, err := h.service.Bulk(c.Request.Context(), userID, ops, req.Atomic)
+	if err != nil {
+		switch {
+		case todo.IsVersionConflict(err):
+			response.Conflict(c, "Bulk operations not applied", "a todo was changed concurrently, retry the request")
+		case todo.IsNotFound(err):
+			response.NotFound(c, "Todo not found")
+		case todo.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to run bulk operations", err.Error())
+		}
+		return
+	}
+
+	resp := BulkResponse{
+		Atomic:  req.Atomic,
+		Results: make([]BulkResultResponse, 0, len(results)),
+	}
+	for i, result := range results {
+		item := BulkResultResponse{
+			Index:  i,
+			Op:     result.Op,
+			Status: bulkStatus(result),
+		}
+		if result.Err != nil {
+			item.Error = result.Err.Error()
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		if result.Todo != nil {
+			todoResp := NewTodoResponse(result.Todo)
+			item.Todo = &todoResp
+		}
+		resp.Results = append(resp.Results, item)
+	}
+
+	switch {
+	case resp.Failed == 0:
+		response.OK(c, "Bulk operations applied successfully", resp)
+	case req.Atomic:
+		response.Success(c, http.StatusMultiStatus, "Bulk operations not applied", resp)
+	default:
+		response.Success(c, http.StatusMultiStatus, "Bulk operations partially applied", resp)
+	}
+}
+
+// newBulkOperation maps a bulk operation request to service input
+func newBulkOperation(req BulkOperationRequest) todo.BulkOperation {
+	op := todo.BulkOperation{
+		Op:      req.Op,
+		ID:      req.ID,
+		Version: req.Version,
+	}
+	if req.Todo == nil {
+		return op
+	}
+
+	fields := req.Todo
+	op.Update = todo.UpdateTodoInput{
+		Title:        fields.Title,
+		Description:  fields.Description,
+		Completed:    fields.Completed,
+		Status:       fields.Status,
+		DueAt:        fields.DueAt,
+		AutoComplete: fields.AutoComplete,
+		Recurrence:   fields.Recurrence,
+		Timezone:     fields.Timezone,
+	}
+	if fields.Priority != nil {
+		priority := entity.TodoPriority(*fields.Priority)
+		op.Update.Priority = &priority
+		op.Create.Priority = priority
+	}
+
+	op.Create.DueAt = fields.DueAt
+	if fields.Title != nil {
+		op.Create.Title = *fields.Title
+	}
+	if fields.Description != nil {
+		op.Create.Description = *fields.Description
+	}
+	if fields.AutoComplete != nil {
+		op.Create.AutoComplete = *fields.AutoComplete
+	}
+	if fields.Recurrence != nil {
+		op.Create.Recurrence = *fields.Recurrence
+	}
+	if fields.Timezone != nil {
+		op.Create.Timezone = *fields.Timezone
+	}
+	return op
+}
+
+// bulkStatus returns the HTTP status describing a bulk operation result
+func bulkStatus(result todo.BulkResult) int {
+	switch {
+	case result.Err == nil && result.Op == todo.BulkCreate:
+		return http.StatusCreated
+	case result.Err == nil:
+		return http.StatusOK
+	case errors.Is(result.Err, todo.ErrBulkAborted):
+		return http.StatusFailedDependency
+	case todo.IsNotFound(result.Err):
+		return http.StatusNotFound
+	case todo.IsForbidden(result.Err):
+		return http.StatusForbidden
+	case todo.IsVersionConflict(result.Err), todo.IsTransitionNotAllowed(result.Err), todo.IsBlocked(result.Err):
+		return http.StatusConflict
+	case todo.IsInvalidInput(result.Err):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ListItems handles GET /api/v1/todos/:id/items
+func (h *Handler) ListItems(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	items, err := h.service.ListItems(c.Request.Context(), userID, todoID)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to get checklist items", err.Error())
+		return
+	}
+
+	response.OK(c, "Checklist items retrieved successfully", NewChecklistItemResponses(items))
+}
+
+// CreateItem handles POST /api/v1/todos/:id/items
+func (h *Handler) CreateItem(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req CreateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	item, err := h.service.AddItem(c.Request.Context(), userID, todoID, todo.AddItemInput{Title: req.Title})
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to create checklist item", err.Error())
+		return
+	}
+
+	response.Created(c, "Checklist item created successfully", NewChecklistItemResponse(item))
+}
+
+// UpdateItem handles PUT /api/v1/todos/:id/items/:itemId
+func (h *Handler) UpdateItem(c *gin.Context) {
+	var req UpdateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	h.updateItem(c, todo.UpdateItemInput{
+		Title:     req.Title,
+		Completed: req.Completed,
+	})
+}
+
+// CompleteItem handles POST /api/v1/todos/:id/items/:itemId/complete
+func (h *Handler) CompleteItem(c *gin.Context) {
+	completed := true
+	h.updateItem(c, todo.UpdateItemInput{Completed: &completed})
+}
+
+// ReopenItem handles POST /api/v1/todos/:id/items/:itemId/reopen
+func (h *Handler) ReopenItem(c *gin.Context) {
+	completed := false
+	h.updateItem(c, todo.UpdateItemInput{Completed: &completed})
+}
+
+// updateItem applies an update to the checklist item addressed by the path
+func (h *Handler) updateItem(c *gin.Context, input todo.UpdateItemInput) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	itemID, ok := param.ID(c, "itemId", "checklist item")
+	if !ok {
+		return
+	}
+
+	item, err := h.service.UpdateItem(c.Request.Context(), userID, todoID, itemID, input)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Checklist item not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to update checklist item", err.Error())
+		return
+	}
+
+	response.OK(c, "Checklist item updated successfully", NewChecklistItemResponse(item))
+}
+
+// DeleteItem handles DELETE /api/v1/todos/:id/items/:itemId
+func (h *Handler) DeleteItem(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	itemID, ok := param.ID(c, "itemId", "checklist item")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteItem(c.Request.Context(), userID, todoID, itemID); err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Checklist item not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		response.InternalServerError(c, "Failed to delete checklist item", err.Error())
+		return
+	}
+
+	response.OK(c, "Checklist item deleted successfully", nil)
+}
+
+// ReorderItems handles PUT /api/v1/todos/:id/items/reorder
+func (h *Handler) ReorderItems(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req ReorderItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	items, err := h.service.ReorderItems(c.Request.Context(), userID, todoID, req.ItemIDs)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", "item_ids must list every checklist item exactly once")
+			return
+		}
+		response.InternalServerError(c, "Failed to reorder checklist items", err.Error())
+		return
+	}
+
+	response.OK(c, "Checklist items reordered successfully", NewChecklistItemResponses(items))
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ListDependencies handles GET /api/v1/todos/:id/dependencies
+func (h *Handler) ListDependencies(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	graph, err := h.service.Dependencies(c.Request.Context(), userID, todoID)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get dependencies", err.Error())
+		return
+	}
+
+	resp := DependencyGraphResponse{
+		TodoID: graph.TodoID,
+		Todos:  make([]DependencyNodeResponse, 0, len(graph.Todos)),
+		Links:  make([]DependencyResponse, 0, len(graph.Links)),
+	}
+	for _, t := range graph.Todos {
+		resp.Todos = append(resp.Todos, DependencyNodeResponse{
+			ID:        t.ID,
+			Title:     t.Title,
+			Status:    t.Status,
+			Completed: t.Completed,
+			Blocked:   graph.Blocked[t.ID],
+		})
+	}
+	for _, link := range graph.Links {
+		resp.Links = append(resp.Links, DependencyResponse{
+			TodoID:    link.TodoID,
+			BlockerID: link.BlockerID,
+		})
+	}
+
+	response.OK(c, "Dependencies retrieved successfully", resp)
+}
+
+// CreateDependency handles POST /api/v1/todos/:id/dependencies.
+// The blocked todo must be writable by the user; the blocker only visible.
+func (h *Handler) CreateDependency(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req CreateDependencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	var link DependencyResponse
+	switch {
+	case req.BlockedBy != nil && req.Blocks == nil:
+		link = DependencyResponse{TodoID: todoID, BlockerID: *req.BlockedBy}
+	case req.Blocks != nil && req.BlockedBy == nil:
+		link = DependencyResponse{TodoID: *req.Blocks, BlockerID: todoID}
+	default:
+		response.BadRequest(c, "Invalid request body", "exactly one of blocked_by and blocks is required")
+		return
+	}
+
+	if err := h.service.AddBlocker(c.Request.Context(), userID, link.TodoID, link.BlockerID); err != nil {
+		switch {
+		case todo.IsNotFound(err):
+			response.NotFound(c, "Todo not found")
+		case todo.IsForbidden(err):
+			auth.ReadOnly(c)
+		case todo.IsDependencyCycle(err):
+			response.Conflict(c, "Dependency not allowed", err.Error())
+		case todo.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", "a todo cannot block itself")
+		default:
+			response.InternalServerError(c, "Failed to add dependency", err.Error())
+		}
+		return
+	}
+
+	response.Created(c, "Dependency added successfully", link)
+}
+
+// DeleteDependency handles DELETE /api/v1/todos/:id/dependencies/:blockerId
+func (h *Handler) DeleteDependency(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	todoID, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+	blockerID, ok := param.ID(c, "blockerId", "blocker")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveBlocker(c.Request.Context(), userID, todoID, blockerID); err != nil {
+		switch {
+		case todo.IsNotFound(err):
+			response.NotFound(c, "Dependency not found")
+		case todo.IsForbidden(err):
+			auth.ReadOnly(c)
+		case todo.IsInvalidInput(err):
+			response.BadRequest(c, "Invalid input", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to remove dependency", err.Error())
+		}
+		return
+	}
+
+	response.OK(c, "Dependency removed successfully", nil)
+}
+
+// writeOptions binds the query parameters of a write to todos
+func writeOptions(c *gin.Context) (WriteOptionsRequest, bool) {
+	var req WriteOptionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return req, false
+	}
+	return req, true
+}
+
+// blocked responds to a completion refused because of open blockers
+func blocked(c *gin.Context) {
+	response.Conflict(c, "Todo is blocked", "the todo has open blockers; complete them first or retry with force=true")
+}
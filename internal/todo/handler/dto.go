@@ -1,37 +1,576 @@
 package handler
 
-import "time"
+import (
+	"encoding/json"
+	"time"
 
-// CreateTodoRequest represents the request body for creating a todo
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// CreateTodoRequest represents the request body for creating a todo.
+// recurrence is an iCalendar RRULE such as "FREQ=WEEKLY;BYDAY=MO,WE",
+// evaluated from due_at in the IANA timezone (UTC when omitted).
 type CreateTodoRequest struct {
-	Title       string `json:"title" binding:"required,min=1,max=255"`
-	Description string `json:"description" binding:"max=1000"`
+	Title        string     `json:"title" binding:"required,min=1,max=255"`
+	Description  string     `json:"description" binding:"max=1000"`
+	Priority     string     `json:"priority" binding:"omitempty,oneof=low medium high urgent"`
+	DueAt        *time.Time `json:"due_at"`
+	AutoComplete bool       `json:"auto_complete"`
+	Recurrence   string     `json:"recurrence" binding:"max=255"`
+	Timezone     string     `json:"timezone" binding:"max=64"`
 }
 
-// UpdateTodoRequest represents the request body for updating a todo
+// UpdateTodoRequest represents the request body for updating a todo.
+// status moves the todo along its workflow; completed on its own moves it
+// to the first done or open status the workflow allows next.
 type UpdateTodoRequest struct {
-	Title       *string `json:"title" binding:"omitempty,min=1,max=255"`
-	Description *string `json:"description" binding:"omitempty,max=1000"`
-	Completed   *bool   `json:"completed"`
+	Title        *string    `json:"title" binding:"omitempty,min=1,max=255"`
+	Description  *string    `json:"description" binding:"omitempty,max=1000"`
+	Completed    *bool      `json:"completed"`
+	Status       *string    `json:"status" binding:"omitempty,min=1,max=32"`
+	Priority     *string    `json:"priority" binding:"omitempty,oneof=low medium high urgent"`
+	DueAt        *time.Time `json:"due_at"`
+	AutoComplete *bool      `json:"auto_complete"`
+	Recurrence   *string    `json:"recurrence" binding:"omitempty,max=255"`
+	Timezone     *string    `json:"timezone" binding:"omitempty,max=64"`
+}
+
+// TodoPatchDocument is the editable form of a todo that PATCH requests are
+// applied to. The patched document is validated with the rules of
+// UpdateTodoRequest; a missing or null due_at clears the due date.
+type TodoPatchDocument struct {
+	Title        string     `json:"title" binding:"required,min=1,max=255"`
+	Description  string     `json:"description" binding:"max=1000"`
+	Completed    bool       `json:"completed"`
+	Status       string     `json:"status" binding:"required,max=32"`
+	Priority     string     `json:"priority" binding:"required,oneof=low medium high urgent"`
+	DueAt        *time.Time `json:"due_at"`
+	AutoComplete bool       `json:"auto_complete"`
+	Recurrence   string     `json:"recurrence" binding:"max=255"`
+	Timezone     string     `json:"timezone" binding:"max=64"`
+}
+
+// TodoFilterRequest represents the query parameters filtering todo listings
+// and exports. Date ranges are RFC3339 timestamps and are inclusive on both
+// ends. due selects overdue todos or todos due today/this week, evaluated in
+// the IANA timezone given by tz (UTC when omitted). tags is a comma-separated
+// list of tag names; match=all requires every tag instead of any of them.
+// status selects one workflow status. ready=true keeps the open todos whose
+// blockers are all completed.
+type TodoFilterRequest struct {
+	Completed   *bool      `form:"completed"`
+	Status      string     `form:"status" binding:"max=32"`
+	Priority    string     `form:"priority" binding:"omitempty,oneof=low medium high urgent"`
+	Tags        string     `form:"tags"`
+	Match       string     `form:"match" binding:"omitempty,oneof=any all"`
+	Due         string     `form:"due" binding:"omitempty,oneof=overdue today week"`
+	TZ          string     `form:"tz"`
+	CreatedFrom *time.Time `form:"created_from"`
+	CreatedTo   *time.Time `form:"created_to"`
+	UpdatedFrom *time.Time `form:"updated_from"`
+	UpdatedTo   *time.Time `form:"updated_to"`
+	Ready       bool       `form:"ready"`
+}
+
+// ListTodosRequest represents the query parameters for listing todos.
+// Setting pagination=cursor or passing a cursor switches to keyset pagination.
+type ListTodosRequest struct {
+	TodoFilterRequest
+	Pagination string `form:"pagination" binding:"omitempty,oneof=offset cursor"`
+	Cursor     string `form:"cursor"`
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	PageSize   int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Sort       string `form:"sort" binding:"omitempty,oneof=created_at updated_at title due_at priority position"`
+	Order      string `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+// BoardRequest represents the query parameters for a project board.
+// limit caps the todos returned per column.
+type BoardRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ListProjectTodosRequest represents the query parameters for listing the
+// todos of a project. sort and order default to the project's settings.
+type ListProjectTodosRequest struct {
+	TodoFilterRequest
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Sort     string `form:"sort" binding:"omitempty,oneof=created_at updated_at title due_at priority position"`
+	Order    string `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+// ExportTodosRequest represents the query parameters for exporting todos.
+// format is csv (RFC 4180), json (JSON Lines) or ics (iCalendar VTODOs).
+type ExportTodosRequest struct {
+	TodoFilterRequest
+	Format string `form:"format" binding:"required,oneof=csv json ics"`
+}
+
+// SearchTodosRequest represents the query parameters for searching todos.
+// q accepts plain words, prefixes such as "deploy*" and quoted phrases.
+type SearchTodosRequest struct {
+	Query    string `form:"q" binding:"required,min=1,max=255"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// ListTrashRequest represents the query parameters for listing trashed todos
+type ListTrashRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// TodoHistoryRequest represents the query parameters for listing a todo's history
+type TodoHistoryRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// RevertTodoRequest represents the request body for reverting a todo to
+// an earlier revision, as listed in its history
+type RevertTodoRequest struct {
+	Version uint `json:"version" binding:"required,min=1"`
+}
+
+// MoveTodoRequest represents the request body for moving a todo to a
+// project. A null or missing project_id moves it to the inbox.
+type MoveTodoRequest struct {
+	ProjectID *uint `json:"project_id" binding:"omitempty,min=1"`
+}
+
+// ReorderTodoRequest represents the request body for moving a todo in the
+// manual ordering: in front of the todo before, behind the todo after, or
+// between the two.
+type ReorderTodoRequest struct {
+	Before *uint `json:"before" binding:"required_without=After,omitempty,min=1"`
+	After  *uint `json:"after" binding:"required_without=Before,omitempty,min=1"`
+}
+
+// OccurrencesRequest represents the query parameters for previewing occurrences
+type OccurrencesRequest struct {
+	Count int `form:"count" binding:"omitempty,min=1,max=50"`
+}
+
+// AttachTagsRequest represents the request body for attaching tags to a todo
+type AttachTagsRequest struct {
+	TagIDs []uint `json:"tag_ids" binding:"required,min=1,max=50,dive,min=1"`
+}
+
+// BulkRequest represents the request body for bulk todo operations.
+// Atomic batches are applied all-or-nothing; otherwise every operation that
+// can be applied is.
+type BulkRequest struct {
+	Atomic     bool                   `json:"atomic"`
+	Operations []BulkOperationRequest `json:"operations" binding:"required,min=1,max=100,dive"`
+}
+
+// BulkOperationRequest represents one bulk operation. create and update
+// operations carry the todo fields of an update request in todo; update,
+// complete and delete operations address a todo by id, only applying when
+// it is still at version if given.
+type BulkOperationRequest struct {
+	Op      string             `json:"op" binding:"required,oneof=create update complete delete"`
+	ID      uint               `json:"id"`
+	Version *uint              `json:"version" binding:"omitempty,min=1"`
+	Todo    *UpdateTodoRequest `json:"todo"`
+}
+
+// TodoTagResponse represents a tag attached to a todo
+type TodoTagResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// TodoProgressResponse represents how many checklist items of a todo are done
+type TodoProgressResponse struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// ImportTodosRequest represents the multipart form fields of an import; the
+// file itself goes in the file field. format defaults to the file extension.
+// mapping is a JSON object naming the source CSV column or JSON key of each
+// todo field, such as {"title": "Task Name"}; unmapped fields keep their name.
+type ImportTodosRequest struct {
+	Format  string `form:"format" binding:"omitempty,oneof=csv json ics"`
+	DryRun  bool   `form:"dry_run"`
+	Mapping string `form:"mapping"`
+}
+
+// ImportTodoRow represents one parsed import row, validated like a request body
+type ImportTodoRow struct {
+	Title       string `binding:"required,min=1,max=255"`
+	Description string `binding:"max=1000"`
+	Completed   bool
+	Priority    string `binding:"omitempty,oneof=low medium high urgent"`
+	DueAt       *time.Time
+	CompletedAt *time.Time
+	Tags        []string `binding:"dive,min=1,max=64"`
+	Recurrence  string   `binding:"max=255"`
+	Timezone    string   `binding:"max=64"`
 }
 
 // TodoResponse represents the response body for a todo
 type TodoResponse struct {
-	ID          uint   `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
+	ID               uint                 `json:"id"`
+	Title            string               `json:"title"`
+	Description      string               `json:"description"`
+	Completed        bool                 `json:"completed"`
+	Status           string               `json:"status"`
+	Priority         string               `json:"priority"`
+	DueAt            *string              `json:"due_at"`
+	CompletedAt      *string              `json:"completed_at"`
+	AutoComplete     bool                 `json:"auto_complete"`
+	Recurrence       string               `json:"recurrence"`
+	Timezone         string               `json:"timezone"`
+	NextOccurrenceID *uint                `json:"next_occurrence_id"`
+	ProjectID        *uint                `json:"project_id"`
+	Position         string               `json:"position"`
+	Version          uint                 `json:"version"`
+	Progress         TodoProgressResponse `json:"progress"`
+	TrackedSeconds   int64                `json:"tracked_seconds"`
+	Tags             []TodoTagResponse    `json:"tags"`
+	CreatedAt        string               `json:"created_at"`
+	UpdatedAt        string               `json:"updated_at"`
+	DeletedAt        *string              `json:"deleted_at,omitempty"`
+}
+
+// TodoExportRecord is the stable schema of an exported todo, used for
+// JSON Lines rows and CSV columns. New fields are only ever appended.
+type TodoExportRecord struct {
+	ID          uint     `json:"id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Completed   bool     `json:"completed"`
+	Priority    string   `json:"priority"`
+	DueAt       *string  `json:"due_at"`
+	CompletedAt *string  `json:"completed_at"`
+	Tags        []string `json:"tags"`
+	Recurrence  string   `json:"recurrence"`
+	Timezone    string   `json:"timezone"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// FieldChangeResponse represents the values of a field before and after a change
+type FieldChangeResponse struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// TodoEventResponse represents one entry of a todo's history. Version is
+// the revision the change produced; actor_id is null for system changes.
+type TodoEventResponse struct {
+	ID        uint                           `json:"id"`
+	Action    string                         `json:"action"`
+	Version   uint                           `json:"version"`
+	ActorID   *uint                          `json:"actor_id"`
+	RequestID string                         `json:"request_id"`
+	Changes   map[string]FieldChangeResponse `json:"changes"`
+	CreatedAt string                         `json:"created_at"`
+}
+
+// TodoHistoryResponse represents the response body for a todo's history
+type TodoHistoryResponse struct {
+	Events     []TodoEventResponse `json:"events"`
+	Total      int64               `json:"total"`
+	Page       int                 `json:"page"`
+	PageSize   int                 `json:"page_size"`
+	TotalPages int                 `json:"total_pages"`
 }
 
 // TodoListResponse represents the response body for a list of todos
 type TodoListResponse struct {
+	Todos      []TodoResponse `json:"todos"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"page_size"`
+	TotalPages int            `json:"total_pages"`
+}
+
+// BoardColumnResponse represents one workflow status of a board with its
+// todos in manual order
+type BoardColumnResponse struct {
+	Key   string         `json:"key"`
+	Name  string         `json:"name"`
+	Done  bool           `json:"done"`
+	Total int64          `json:"total"`
 	Todos []TodoResponse `json:"todos"`
-	Total int            `json:"total"`
+}
+
+// BoardResponse represents the response body for a project board
+type BoardResponse struct {
+	ProjectID uint                  `json:"project_id"`
+	Columns   []BoardColumnResponse `json:"columns"`
+}
+
+// TodoCursorListResponse represents the response body for a keyset page of todos
+type TodoCursorListResponse struct {
+	Todos      []TodoResponse `json:"todos"`
+	PageSize   int            `json:"page_size"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	PrevCursor string         `json:"prev_cursor,omitempty"`
+}
+
+// BulkResultResponse represents the outcome of one bulk operation
+type BulkResultResponse struct {
+	Index  int           `json:"index"`
+	Op     string        `json:"op"`
+	Status int           `json:"status"`
+	Error  string        `json:"error,omitempty"`
+	Todo   *TodoResponse `json:"todo,omitempty"`
+}
+
+// ImportRowResponse represents the outcome of one import row. Rows are
+// numbered from 1, excluding the CSV header.
+type ImportRowResponse struct {
+	Row         int               `json:"row"`
+	Status      string            `json:"status"`
+	Errors      map[string]string `json:"errors,omitempty"`
+	DuplicateOf *uint             `json:"duplicate_of,omitempty"`
+	Todo        *TodoResponse     `json:"todo,omitempty"`
+}
+
+// ImportResponse represents the response body for an import
+type ImportResponse struct {
+	DryRun     bool                `json:"dry_run"`
+	Total      int                 `json:"total"`
+	Created    int                 `json:"created"`
+	Valid      int                 `json:"valid"`
+	Invalid    int                 `json:"invalid"`
+	Duplicates int                 `json:"duplicates"`
+	Rows       []ImportRowResponse `json:"rows"`
+}
+
+// BulkResponse represents the response body for bulk todo operations
+type BulkResponse struct {
+	Atomic    bool                 `json:"atomic"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []BulkResultResponse `json:"results"`
+}
+
+// TodoSearchResultResponse represents a single full-text search match
+type TodoSearchResultResponse struct {
+	Todo       TodoResponse           `json:"todo"`
+	Rank       float64                `json:"rank"`
+	Highlights TodoHighlightsResponse `json:"highlights"`
+}
+
+// TodoHighlightsResponse holds HTML-escaped snippets with matches wrapped
+// in <mark></mark>
+type TodoHighlightsResponse struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// TodoSearchResponse represents the response body for a todo search
+type TodoSearchResponse struct {
+	Results    []TodoSearchResultResponse `json:"results"`
+	Total      int64                      `json:"total"`
+	Page       int                        `json:"page"`
+	PageSize   int                        `json:"page_size"`
+	TotalPages int                        `json:"total_pages"`
+}
+
+// TodoOccurrencesResponse represents the upcoming occurrences of a recurring todo
+type TodoOccurrencesResponse struct {
+	Occurrences []string `json:"occurrences"`
+}
+
+// CreateItemRequest represents the request body for adding a checklist item
+type CreateItemRequest struct {
+	Title string `json:"title" binding:"required,min=1,max=255"`
+}
+
+// UpdateItemRequest represents the request body for updating a checklist item
+type UpdateItemRequest struct {
+	Title     *string `json:"title" binding:"omitempty,min=1,max=255"`
+	Completed *bool   `json:"completed"`
+}
+
+// ReorderItemsRequest represents the request body for reordering a checklist.
+// item_ids must list every item of the todo exactly once.
+type ReorderItemsRequest struct {
+	ItemIDs []uint `json:"item_ids" binding:"required,min=1,dive,min=1"`
+}
+
+// ChecklistItemResponse represents the response body for a checklist item
+type ChecklistItemResponse struct {
+	ID          uint    `json:"id"`
+	Title       string  `json:"title"`
+	Completed   bool    `json:"completed"`
+	Position    int     `json:"position"`
+	CompletedAt *string `json:"completed_at"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+// NewChecklistItemResponse maps a checklist item entity to its response body
+func NewChecklistItemResponse(item *entity.ChecklistItem) ChecklistItemResponse {
+	return ChecklistItemResponse{
+		ID:          item.ID,
+		Title:       item.Title,
+		Completed:   item.Completed,
+		Position:    item.Position,
+		CompletedAt: FormatOptionalTime(item.CompletedAt),
+		CreatedAt:   FormatTime(item.CreatedAt),
+		UpdatedAt:   FormatTime(item.UpdatedAt),
+	}
+}
+
+// NewChecklistItemResponses maps checklist item entities to their response bodies
+func NewChecklistItemResponses(items []entity.ChecklistItem) []ChecklistItemResponse {
+	responses := make([]ChecklistItemResponse, 0, len(items))
+	for i := range items {
+		responses = append(responses, NewChecklistItemResponse(&items[i]))
+	}
+	return responses
+}
+
+// NewTodoResponse maps a todo entity to its response body
+func NewTodoResponse(t *entity.Todo) TodoResponse {
+	tags := make([]TodoTagResponse, 0, len(t.Tags))
+	for _, tag := range t.Tags {
+		tags = append(tags, TodoTagResponse{
+			ID:    tag.ID,
+			Name:  tag.Name,
+			Color: tag.Color,
+		})
+	}
+
+	resp := TodoResponse{
+		ID:               t.ID,
+		Title:            t.Title,
+		Description:      t.Description,
+		Completed:        t.Completed,
+		Status:           t.Status,
+		Priority:         string(t.Priority),
+		DueAt:            FormatOptionalTime(t.DueAt),
+		CompletedAt:      FormatOptionalTime(t.CompletedAt),
+		AutoComplete:     t.AutoComplete,
+		Recurrence:       t.Recurrence,
+		Timezone:         t.Timezone,
+		NextOccurrenceID: t.NextOccurrenceID,
+		ProjectID:        t.ProjectID,
+		Position:         t.Position,
+		Version:          t.Version,
+		Progress: TodoProgressResponse{
+			Done:  t.Progress.Done,
+			Total: t.Progress.Total,
+		},
+		TrackedSeconds: int64(t.TrackedTime / time.Second),
+		Tags:           tags,
+		CreatedAt:      FormatTime(t.CreatedAt),
+		UpdatedAt:      FormatTime(t.UpdatedAt),
+	}
+	if t.DeletedAt.Valid {
+		resp.DeletedAt = FormatOptionalTime(&t.DeletedAt.Time)
+	}
+	return resp
+}
+
+// NewTodoExportRecord maps a todo entity to its export record
+func NewTodoExportRecord(t *entity.Todo) TodoExportRecord {
+	tags := make([]string, 0, len(t.Tags))
+	for _, tag := range t.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	return TodoExportRecord{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description,
+		Completed:   t.Completed,
+		Priority:    string(t.Priority),
+		DueAt:       FormatOptionalTime(t.DueAt),
+		CompletedAt: FormatOptionalTime(t.CompletedAt),
+		Tags:        tags,
+		Recurrence:  t.Recurrence,
+		Timezone:    t.Timezone,
+		CreatedAt:   FormatTime(t.CreatedAt),
+		UpdatedAt:   FormatTime(t.UpdatedAt),
+	}
+}
+
+// NewTodoEventResponse maps a todo event to its response body
+func NewTodoEventResponse(e *entity.TodoEvent) TodoEventResponse {
+	changes := make(map[string]FieldChangeResponse, len(e.Changes))
+	for name, change := range e.Changes {
+		changes[name] = FieldChangeResponse{Before: change.Before, After: change.After}
+	}
+
+	return TodoEventResponse{
+		ID:        e.ID,
+		Action:    string(e.Action),
+		Version:   e.Version,
+		ActorID:   e.ActorID,
+		RequestID: e.RequestID,
+		Changes:   changes,
+		CreatedAt: FormatTime(e.CreatedAt),
+	}
+}
+
+// NewTodoResponses maps a list of todo entities to their response bodies
+func NewTodoResponses(todos []entity.Todo) []TodoResponse {
+	responses := make([]TodoResponse, 0, len(todos))
+	for i := range todos {
+		responses = append(responses, NewTodoResponse(&todos[i]))
+	}
+	return responses
 }
 
 // FormatTime formats time to RFC3339
 func FormatTime(t time.Time) string {
 	return t.Format(time.RFC3339)
 }
+
+// FormatOptionalTime formats an optional time to RFC3339, keeping nil as nil
+func FormatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := FormatTime(*t)
+	return &formatted
+}
+
+// WriteOptionsRequest represents the query parameters of writes to todos.
+// force=true completes todos even when their blockers are still open.
+type WriteOptionsRequest struct {
+	Force bool `form:"force"`
+}
+
+// CreateDependencyRequest represents the request body for linking two
+// todos. Exactly one of blocked_by (the todo is blocked by that todo) and
+// blocks (the todo blocks that todo) must be set.
+type CreateDependencyRequest struct {
+	BlockedBy *uint `json:"blocked_by" binding:"omitempty,min=1"`
+	Blocks    *uint `json:"blocks" binding:"omitempty,min=1"`
+}
+
+// DependencyResponse represents the response body for a link between two
+// todos: todo_id is blocked by blocker_id
+type DependencyResponse struct {
+	TodoID    uint `json:"todo_id"`
+	BlockerID uint `json:"blocker_id"`
+}
+
+// DependencyNodeResponse represents a todo of a dependency graph. blocked
+// is set when the todo has open blockers.
+type DependencyNodeResponse struct {
+	ID        uint   `json:"id"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	Completed bool   `json:"completed"`
+	Blocked   bool   `json:"blocked"`
+}
+
+// DependencyGraphResponse represents the response body for the dependency
+// graph around a todo
+type DependencyGraphResponse struct {
+	TodoID uint                     `json:"todo_id"`
+	Todos  []DependencyNodeResponse `json:"todos"`
+	Links  []DependencyResponse     `json:"links"`
+}
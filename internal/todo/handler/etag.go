@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// todoETag returns the strong entity tag of a todo's current version
+func todoETag(t *entity.Todo) string {
+	return `"` + strconv.FormatUint(uint64(t.Version), 10) + `"`
+}
+
+// etagMatches reports whether an If-Match or If-None-Match header value
+// lists the entity tag. If-Match uses strong comparison, so weak tags only
+// match when weak is set, as If-None-Match requires.
+func etagMatches(header, etag string, weak bool) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if weak {
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion evaluates the If-Match header of a write to a todo. It
+// returns the version the write must apply to, or nil when the request
+// carries no precondition. ok is false once an error response has been sent:
+// 428 when If-Match is required but missing, 412 when it does not match.
+func (h *Handler) ifMatchVersion(c *gin.Context, userID, id uint) (version *uint, ok bool) {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		if h.requireIfMatch {
+			response.PreconditionRequired(c, "Precondition required", "If-Match header with the todo's ETag is required")
+			return nil, false
+		}
+		return nil, true
+	}
+
+	current, err := h.service.GetByID(c.Request.Context(), userID, id)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return nil, false
+		}
+		response.InternalServerError(c, "Failed to get todo", err.Error())
+		return nil, false
+	}
+
+	if !etagMatches(header, todoETag(current), false) {
+		response.PreconditionFailed(c, "Todo has been modified", "If-Match does not match the current ETag "+todoETag(current))
+		return nil, false
+	}
+	return &current.Version, true
+}
+
+// versionConflict responds to a write that lost a race with another change
+// to the todo: 412 when the client sent If-Match, 409 otherwise
+func versionConflict(c *gin.Context) {
+	if c.GetHeader("If-Match") != "" {
+		response.PreconditionFailed(c, "Todo has been modified", "If-Match does not match the current ETag")
+		return
+	}
+	response.Conflict(c, "Todo has been modified", "the todo was changed concurrently, retry the request")
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func TestTodoETag(t *testing.T) {
+	if got, want := todoETag(&entity.Todo{Version: 42}), `"42"`; got != want {
+		t.Errorf("todoETag() = %s, want %s", got, want)
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		weak   bool
+		want   bool
+	}{
+		{name: "same tag", header: `"3"`, want: true},
+		{name: "other tag", header: `"2"`, want: false},
+		{name: "one of a list", header: `"1", "3" ,"5"`, want: true},
+		{name: "none of a list", header: `"1", "2"`, want: false},
+		{name: "any tag", header: "*", want: true},
+		{name: "unquoted tag", header: "3", want: false},
+		{name: "weak tag with strong comparison", header: `W/"3"`, want: false},
+		{name: "weak tag with weak comparison", header: `W/"3"`, weak: true, want: true},
+		{name: "weak tag in a list with weak comparison", header: `"1", W/"3"`, weak: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.header, `"3"`, tt.weak); got != tt.want {
+				t.Errorf("etagMatches(%q, weak=%v) = %v, want %v", tt.header, tt.weak, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/ical"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// icalProductID identifies this application in exported calendars
+const icalProductID = "-//golden-architecture//todos//EN"
+
+// csvHeader lists the exported CSV columns, in TodoExportRecord order
+var csvHeader = []string{
+	"id", "title", "description", "completed", "priority", "due_at",
+	"completed_at", "tags", "recurrence", "timezone", "created_at", "updated_at",
+}
+
+// icalPriorities maps todo priorities to iCalendar PRIORITY values
+var icalPriorities = map[entity.TodoPriority]int{
+	entity.PriorityUrgent: 1,
+	entity.PriorityHigh:   3,
+	entity.PriorityMedium: 5,
+	entity.PriorityLow:    9,
+}
+
+// exportEncoder writes exported todos in one format
+type exportEncoder interface {
+	begin() error
+	write(todos []entity.Todo) error
+	end() error
+}
+
+// exportFormat describes how a format is encoded and served
+type exportFormat struct {
+	contentType string
+	extension   string
+	encoder     func(w io.Writer) exportEncoder
+}
+
+var exportFormats = map[string]exportFormat{
+	"csv": {
+		contentType: "text/csv; charset=utf-8",
+		extension:   "csv",
+		encoder:     func(w io.Writer) exportEncoder { return newCSVEncoder(w) },
+	},
+	"json": {
+		contentType: "application/x-ndjson",
+		extension:   "jsonl",
+		encoder:     func(w io.Writer) exportEncoder { return &jsonLinesEncoder{encoder: json.NewEncoder(w)} },
+	},
+	"ics": {
+		contentType: "text/calendar; charset=utf-8",
+		extension:   "ics",
+		encoder: func(w io.Writer) exportEncoder {
+			return &icalEncoder{writer: ical.NewWriter(w, icalProductID), stamp: time.Now()}
+		},
+	},
+}
+
+// Export handles GET /todos/export. Todos are streamed in batches as they
+// are read; errors after the first batch has been sent truncate the output.
+func (h *Handler) Export(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req ExportTodosRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	input, err := filterInput(req.TodoFilterRequest)
+	if err != nil {
+		response.BadRequest(c, "Invalid timezone", err.Error())
+		return
+	}
+
+	format := exportFormats[req.Format]
+	encoder := format.encoder(c.Writer)
+	started := false
+	start := func() error {
+		started = true
+		c.Header("Content-Type", format.contentType)
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="todos.%s"`, format.extension))
+		c.Status(http.StatusOK)
+		return encoder.begin()
+	}
+
+	err = h.service.Export(c.Request.Context(), userID, input, func(todos []entity.Todo) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		if err := encoder.write(todos); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err == nil && !started {
+		err = start()
+	}
+	if err == nil {
+		err = encoder.end()
+	}
+	if err != nil {
+		if started {
+			// Headers are gone; record the error and cut the stream short
+			_ = c.Error(err)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid query parameters", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to export todos", err.Error())
+	}
+}
+
+// csvEncoder writes RFC 4180 CSV with a header row
+type csvEncoder struct {
+	writer *csv.Writer
+}
+
+func newCSVEncoder(w io.Writer) *csvEncoder {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+	return &csvEncoder{writer: writer}
+}
+
+func (e *csvEncoder) begin() error {
+	return e.writer.Write(csvHeader)
+}
+
+func (e *csvEncoder) write(todos []entity.Todo) error {
+	for i := range todos {
+		record := NewTodoExportRecord(&todos[i])
+		row := []string{
+			strconv.FormatUint(uint64(record.ID), 10),
+			record.Title,
+			record.Description,
+			strconv.FormatBool(record.Completed),
+			record.Priority,
+			optionalString(record.DueAt),
+			optionalString(record.CompletedAt),
+			strings.Join(record.Tags, ","),
+			record.Recurrence,
+			record.Timezone,
+			record.CreatedAt,
+			record.UpdatedAt,
+		}
+		if err := e.writer.Write(row); err != nil {
+			return err
+		}
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) end() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// jsonLinesEncoder writes one TodoExportRecord per line
+type jsonLinesEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *jsonLinesEncoder) begin() error { return nil }
+
+func (e *jsonLinesEncoder) write(todos []entity.Todo) error {
+	for i := range todos {
+		if err := e.encoder.Encode(NewTodoExportRecord(&todos[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *jsonLinesEncoder) end() error { return nil }
+
+// icalEncoder writes a calendar of VTODO components
+type icalEncoder struct {
+	writer *ical.Writer
+	stamp  time.Time
+}
+
+func (e *icalEncoder) begin() error {
+	return e.writer.Begin()
+}
+
+func (e *icalEncoder) write(todos []entity.Todo) error {
+	for i := range todos {
+		if err := e.writer.WriteTodo(newICalTodo(&todos[i]), e.stamp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *icalEncoder) end() error {
+	return e.writer.End()
+}
+
+// newICalTodo maps a todo entity to a VTODO component
+func newICalTodo(t *entity.Todo) *ical.Todo {
+	status := ical.StatusNeedsAction
+	if t.Completed {
+		status = ical.StatusCompleted
+	}
+
+	categories := make([]string, 0, len(t.Tags))
+	for _, tag := range t.Tags {
+		categories = append(categories, tag.Name)
+	}
+
+	return &ical.Todo{
+		UID:          fmt.Sprintf("todo-%d@golden-architecture", t.ID),
+		Summary:      t.Title,
+		Description:  t.Description,
+		Status:       status,
+		Priority:     icalPriorities[t.Priority],
+		Due:          t.DueAt,
+		Completed:    t.CompletedAt,
+		Created:      t.CreatedAt,
+		LastModified: t.UpdatedAt,
+		Categories:   categories,
+		RRule:        t.Recurrence,
+	}
+}
+
+// optionalString returns the value of s, or an empty string when nil
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/pkg/ical"
+)
+
+// exportTodos are two batches of todos to export
+func exportTodos() [][]entity.Todo {
+	created := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	return [][]entity.Todo{
+		{{
+			ID:          1,
+			Title:       `Buy "milk", eggs`,
+			Description: "First line\nsecond line",
+			Completed:   true,
+			Priority:    entity.PriorityUrgent,
+			DueAt:       &due,
+			CompletedAt: &due,
+			Tags:        []entity.Tag{{Name: "home"}, {Name: "errands"}},
+			Recurrence:  "FREQ=WEEKLY",
+			Timezone:    "UTC",
+			CreatedAt:   created,
+			UpdatedAt:   due,
+		}},
+		{{
+			ID:        2,
+			Title:     "Call",
+			Priority:  entity.PriorityLow,
+			Timezone:  "UTC",
+			CreatedAt: created,
+			UpdatedAt: created,
+		}},
+	}
+}
+
+// export encodes the batches in a format
+func export(t *testing.T, format string, batches [][]entity.Todo) string {
+	t.Helper()
+	var buf bytes.Buffer
+	encoder := exportFormats[format].encoder(&buf)
+	if err := encoder.begin(); err != nil {
+		t.Fatalf("begin() unexpected error: %v", err)
+	}
+	for _, batch := range batches {
+		if err := encoder.write(batch); err != nil {
+			t.Fatalf("write() unexpected error: %v", err)
+		}
+	}
+	if err := encoder.end(); err != nil {
+		t.Fatalf("end() unexpected error: %v", err)
+	}
+	return buf.String()
+}
+
+func TestExportEncoders(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		batches [][]entity.Todo
+		want    string
+	}{
+		{
+			name:    "csv",
+			format:  "csv",
+			batches: exportTodos(),
+			want: "id,title,description,completed,priority,due_at,completed_at,tags,recurrence,timezone,created_at,updated_at\r\n" +
+				"1,\"Buy \"\"milk\"\", eggs\",\"First line\r\nsecond line\",true,urgent,2026-03-02T09:30:00Z,2026-03-02T09:30:00Z,\"home,errands\",FREQ=WEEKLY,UTC,2026-03-01T08:00:00Z,2026-03-02T09:30:00Z\r\n" +
+				"2,Call,,false,low,,,,,UTC,2026-03-01T08:00:00Z,2026-03-01T08:00:00Z\r\n",
+		},
+		{
+			name:   "csv without todos",
+			format: "csv",
+			want:   "id,title,description,completed,priority,due_at,completed_at,tags,recurrence,timezone,created_at,updated_at\r\n",
+		},
+		{
+			name:    "json lines",
+			format:  "json",
+			batches: exportTodos(),
+			want: `{"id":1,"title":"Buy \"milk\", eggs","description":"First line\nsecond line","completed":true,"priority":"urgent","due_at":"2026-03-02T09:30:00Z","completed_at":"2026-03-02T09:30:00Z","tags":["home","errands"],"recurrence":"FREQ=WEEKLY","timezone":"UTC","created_at":"2026-03-01T08:00:00Z","updated_at":"2026-03-02T09:30:00Z"}` + "\n" +
+				`{"id":2,"title":"Call","description":"","completed":false,"priority":"low","due_at":null,"completed_at":null,"tags":[],"recurrence":"","timezone":"UTC","created_at":"2026-03-01T08:00:00Z","updated_at":"2026-03-01T08:00:00Z"}` + "\n",
+		},
+		{
+			name:   "json lines without todos",
+			format: "json",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := export(t, tt.format, tt.batches); got != tt.want {
+				t.Errorf("export = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportICal(t *testing.T) {
+	out := export(t, "ics", exportTodos())
+	if !strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Fatalf("export is not a calendar:\n%s", out)
+	}
+
+	reader := ical.NewReader(strings.NewReader(out))
+	tests := []struct {
+		uid        string
+		summary    string
+		status     string
+		priority   int
+		categories []string
+	}{
+		{uid: "todo-1@golden-architecture", summary: `Buy "milk", eggs`, status: ical.StatusCompleted, priority: 1, categories: []string{"home", "errands"}},
+		{uid: "todo-2@golden-architecture", summary: "Call", status: ical.StatusNeedsAction, priority: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uid, func(t *testing.T) {
+			got, err := reader.ReadTodo()
+			if err != nil {
+				t.Fatalf("ReadTodo() unexpected error: %v", err)
+			}
+			if got.UID != tt.uid || got.Summary != tt.summary || got.Status != tt.status || got.Priority != tt.priority {
+				t.Errorf("todo = %+v, want %s %q %s priority %d", got, tt.uid, tt.summary, tt.status, tt.priority)
+			}
+			if strings.Join(got.Categories, "|") != strings.Join(tt.categories, "|") {
+				t.Errorf("categories = %q, want %q", got.Categories, tt.categories)
+			}
+		})
+	}
+	if _, err := reader.ReadTodo(); err != io.EOF {
+		t.Errorf("ReadTodo() after the last todo error = %v, want io.EOF", err)
+	}
+}
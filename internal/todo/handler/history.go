@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// History handles GET /api/v1/todos/:id/history
+func (h *Handler) History(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req TodoHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	result, err := h.service.History(c.Request.Context(), userID, id, todo.HistoryInput{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get todo history", err.Error())
+		return
+	}
+
+	events := make([]TodoEventResponse, 0, len(result.Events))
+	for i := range result.Events {
+		events = append(events, NewTodoEventResponse(&result.Events[i]))
+	}
+
+	resp := TodoHistoryResponse{
+		Events:     events,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages(),
+	}
+
+	response.OK(c, "Todo history retrieved successfully", resp)
+}
+
+// Revert handles POST /api/v1/todos/:id/revert
+func (h *Handler) Revert(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req RevertTodoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	version, ok := h.ifMatchVersion(c, userID, id)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Revert(c.Request.Context(), userID, id, req.Version, version)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo revision not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsVersionConflict(err) {
+			versionConflict(c)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", "version must be an earlier revision of the todo")
+			return
+		}
+		response.InternalServerError(c, "Failed to revert todo", err.Error())
+		return
+	}
+
+	c.Header("ETag", todoETag(result))
+	response.OK(c, "Todo reverted successfully", NewTodoResponse(result))
+}
@@ -1,25 +1,85 @@
 package handler
 
 import (
-	"strconv"
+	"strings"
+	"time"
 
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
 	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/cursor"
+	"github.com/arulkarim/golden-architecture/pkg/param"
 	"github.com/arulkarim/golden-architecture/pkg/response"
 	"github.com/gin-gonic/gin"
 )
 
-// Handler handles HTTP requests for todos
+// Handler handles HTTP requests for todos.
+// When requireIfMatch is set, writes to a todo must carry an If-Match header.
 type Handler struct {
-	service *todo.Service
+	service        *todo.Service
+	cursors        *cursor.Codec
+	requireIfMatch bool
 }
 
 // NewHandler creates a new todo handler
-func NewHandler(service *todo.Service) *Handler {
-	return &Handler{service: service}
+func NewHandler(service *todo.Service, cursors *cursor.Codec, requireIfMatch bool) *Handler {
+	return &Handler{
+		service:        service,
+		cursors:        cursors,
+		requireIfMatch: requireIfMatch,
+	}
+}
+
+// parseLocation resolves an optional IANA timezone name, defaulting to UTC
+func parseLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// filterInput maps the filter query parameters to listing input
+func filterInput(req TodoFilterRequest) (todo.ListTodosInput, error) {
+	location, err := parseLocation(req.TZ)
+	if err != nil {
+		return todo.ListTodosInput{}, err
+	}
+
+	return todo.ListTodosInput{
+		Completed:   req.Completed,
+		Status:      req.Status,
+		Priority:    entity.TodoPriority(req.Priority),
+		Tags:        splitList(req.Tags),
+		TagMatch:    req.Match,
+		Due:         req.Due,
+		Location:    location,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		UpdatedFrom: req.UpdatedFrom,
+		UpdatedTo:   req.UpdatedTo,
+		Ready:       req.Ready,
+	}, nil
+}
+
+// splitList splits a comma-separated query value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 // Create handles POST /api/v1/todos
 func (h *Handler) Create(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
 	var req CreateTodoRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		response.BadRequest(c, "Invalid request body", err.Error())
@@ -27,11 +87,16 @@ func (h *Handler) Create(c *gin.Context) {
 	}
 
 	input := todo.CreateTodoInput{
-		Title:       req.Title,
-		Description: req.Description,
+		Title:        req.Title,
+		Description:  req.Description,
+		Priority:     entity.TodoPriority(req.Priority),
+		DueAt:        req.DueAt,
+		AutoComplete: req.AutoComplete,
+		Recurrence:   req.Recurrence,
+		Timezone:     req.Timezone,
 	}
 
-	result, err := h.service.Create(c.Request.Context(), input)
+	result, err := h.service.Create(c.Request.Context(), userID, input)
 	if err != nil {
 		if todo.IsInvalidInput(err) {
 			response.BadRequest(c, "Invalid input", err.Error())
@@ -41,41 +106,169 @@ func (h *Handler) Create(c *gin.Context) {
 		return
 	}
 
-	resp := TodoResponse{
-		ID:          result.ID,
-		Title:       result.Title,
-		Description: result.Description,
-		Completed:   result.Completed,
-		CreatedAt:   FormatTime(result.CreatedAt),
-		UpdatedAt:   FormatTime(result.UpdatedAt),
-	}
+	resp := NewTodoResponse(result)
 
+	c.Header("ETag", todoETag(result))
 	response.Created(c, "Todo created successfully", resp)
 }
 
 // GetAll handles GET /api/v1/todos
 func (h *Handler) GetAll(c *gin.Context) {
-	todos, err := h.service.GetAll(c.Request.Context())
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req ListTodosRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	input, err := filterInput(req.TodoFilterRequest)
+	if err != nil {
+		response.BadRequest(c, "Invalid timezone", err.Error())
+		return
+	}
+	input.Page = req.Page
+	input.PageSize = req.PageSize
+	input.SortBy = req.Sort
+	input.SortOrder = req.Order
+
+	if req.Pagination == "cursor" || req.Cursor != "" {
+		h.getAllByCursor(c, userID, input, req.Cursor)
+		return
+	}
+
+	page, err := h.service.GetAll(c.Request.Context(), userID, input)
+	if err != nil {
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid query parameters", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get todos", err.Error())
+		return
+	}
+
+	resp := TodoListResponse{
+		Todos:      NewTodoResponses(page.Todos),
+		Total:      page.Total,
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		TotalPages: page.TotalPages(),
+	}
+
+	response.OK(c, "Todos retrieved successfully", resp)
+}
+
+// cursorPayload is the signed content of an opaque todo list cursor
+type cursorPayload struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uint      `json:"i"`
+	Backward  bool      `json:"b,omitempty"`
+}
+
+// getAllByCursor serves GET /api/v1/todos in keyset pagination mode
+func (h *Handler) getAllByCursor(c *gin.Context, userID uint, input todo.ListTodosInput, token string) {
+	var cursor *todo.TodoCursor
+	if token != "" {
+		var payload cursorPayload
+		if err := h.cursors.Decode(token, &payload); err != nil {
+			response.BadRequest(c, "Invalid cursor", err.Error())
+			return
+		}
+		cursor = &todo.TodoCursor{
+			Key:      contract.TodoKey{CreatedAt: payload.CreatedAt, ID: payload.ID},
+			Backward: payload.Backward,
+		}
+	}
+
+	page, err := h.service.GetAllByCursor(c.Request.Context(), userID, input, cursor)
 	if err != nil {
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid query parameters", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get todos", err.Error())
+		return
+	}
+
+	resp := TodoCursorListResponse{
+		Todos:    NewTodoResponses(page.Todos),
+		PageSize: page.PageSize,
+	}
+	if resp.NextCursor, err = h.encodeCursor(page.Next); err != nil {
 		response.InternalServerError(c, "Failed to get todos", err.Error())
 		return
 	}
+	if resp.PrevCursor, err = h.encodeCursor(page.Prev); err != nil {
+		response.InternalServerError(c, "Failed to get todos", err.Error())
+		return
+	}
+
+	response.OK(c, "Todos retrieved successfully", resp)
+}
+
+// encodeCursor signs a cursor for the response, returning "" for nil cursors
+func (h *Handler) encodeCursor(cursor *todo.TodoCursor) (string, error) {
+	if cursor == nil {
+		return "", nil
+	}
+	return h.cursors.Encode(cursorPayload{
+		CreatedAt: cursor.Key.CreatedAt,
+		ID:        cursor.Key.ID,
+		Backward:  cursor.Backward,
+	})
+}
+
+// Search handles GET /api/v1/todos/search
+func (h *Handler) Search(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
 
-	var todoResponses []TodoResponse
-	for _, t := range todos {
-		todoResponses = append(todoResponses, TodoResponse{
-			ID:          t.ID,
-			Title:       t.Title,
-			Description: t.Description,
-			Completed:   t.Completed,
-			CreatedAt:   FormatTime(t.CreatedAt),
-			UpdatedAt:   FormatTime(t.UpdatedAt),
+	var req SearchTodosRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	input := todo.SearchTodosInput{
+		Query:    req.Query,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	page, err := h.service.Search(c.Request.Context(), userID, input)
+	if err != nil {
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid search query", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to search todos", err.Error())
+		return
+	}
+
+	results := make([]TodoSearchResultResponse, 0, len(page.Results))
+	for i := range page.Results {
+		r := &page.Results[i]
+		results = append(results, TodoSearchResultResponse{
+			Todo: NewTodoResponse(&r.Todo),
+			Rank: r.Rank,
+			Highlights: TodoHighlightsResponse{
+				Title:       r.TitleHighlight,
+				Description: r.DescriptionHighlight,
+			},
 		})
 	}
 
-	resp := TodoListResponse{
-		Todos: todoResponses,
-		Total: len(todoResponses),
+	resp := TodoSearchResponse{
+		Results:    results,
+		Total:      page.Total,
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		TotalPages: page.TotalPages(),
 	}
 
 	response.OK(c, "Todos retrieved successfully", resp)
@@ -83,14 +276,17 @@ func (h *Handler) GetAll(c *gin.Context) {
 
 // GetByID handles GET /api/v1/todos/:id
 func (h *Handler) GetByID(c *gin.Context) {
-	idParam := c.Param("id")
-	id, err := strconv.ParseUint(idParam, 10, 32)
-	if err != nil {
-		response.BadRequest(c, "Invalid todo ID", "ID must be a positive integer")
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
 		return
 	}
 
-	result, err := h.service.GetByID(c.Request.Context(), uint(id))
+	result, err := h.service.GetByID(c.Request.Context(), userID, id)
 	if err != nil {
 		if todo.IsNotFound(err) {
 			response.NotFound(c, "Todo not found")
@@ -100,24 +296,67 @@ func (h *Handler) GetByID(c *gin.Context) {
 		return
 	}
 
-	resp := TodoResponse{
-		ID:          result.ID,
-		Title:       result.Title,
-		Description: result.Description,
-		Completed:   result.Completed,
-		CreatedAt:   FormatTime(result.CreatedAt),
-		UpdatedAt:   FormatTime(result.UpdatedAt),
+	etag := todoETag(result)
+	c.Header("ETag", etag)
+	if header := c.GetHeader("If-None-Match"); header != "" && etagMatches(header, etag, true) {
+		response.NotModified(c)
+		return
 	}
 
+	resp := NewTodoResponse(result)
+
 	response.OK(c, "Todo retrieved successfully", resp)
 }
 
+// Occurrences handles GET /api/v1/todos/:id/occurrences
+func (h *Handler) Occurrences(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req OccurrencesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	occurrences, err := h.service.Occurrences(c.Request.Context(), userID, id, req.Count)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to preview occurrences", err.Error())
+		return
+	}
+
+	resp := TodoOccurrencesResponse{Occurrences: make([]string, 0, len(occurrences))}
+	for _, occurrence := range occurrences {
+		resp.Occurrences = append(resp.Occurrences, FormatTime(occurrence))
+	}
+
+	response.OK(c, "Occurrences retrieved successfully", resp)
+}
+
 // Update handles PUT /api/v1/todos/:id
 func (h *Handler) Update(c *gin.Context) {
-	idParam := c.Param("id")
-	id, err := strconv.ParseUint(idParam, 10, 32)
-	if err != nil {
-		response.BadRequest(c, "Invalid todo ID", "ID must be a positive integer")
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
 		return
 	}
 
@@ -126,19 +365,55 @@ func (h *Handler) Update(c *gin.Context) {
 		response.BadRequest(c, "Invalid request body", err.Error())
 		return
 	}
+	options, ok := writeOptions(c)
+	if !ok {
+		return
+	}
+
+	version, ok := h.ifMatchVersion(c, userID, id)
+	if !ok {
+		return
+	}
 
 	input := todo.UpdateTodoInput{
-		Title:       req.Title,
-		Description: req.Description,
-		Completed:   req.Completed,
+		Title:        req.Title,
+		Description:  req.Description,
+		Completed:    req.Completed,
+		Status:       req.Status,
+		DueAt:        req.DueAt,
+		AutoComplete: req.AutoComplete,
+		Recurrence:   req.Recurrence,
+		Timezone:     req.Timezone,
+		Version:      version,
+		Force:        options.Force,
+	}
+	if req.Priority != nil {
+		priority := entity.TodoPriority(*req.Priority)
+		input.Priority = &priority
 	}
 
-	result, err := h.service.Update(c.Request.Context(), uint(id), input)
+	result, err := h.service.Update(c.Request.Context(), userID, id, input)
 	if err != nil {
 		if todo.IsNotFound(err) {
 			response.NotFound(c, "Todo not found")
 			return
 		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsVersionConflict(err) {
+			versionConflict(c)
+			return
+		}
+		if todo.IsTransitionNotAllowed(err) {
+			response.Conflict(c, "Status transition not allowed", err.Error())
+			return
+		}
+		if todo.IsBlocked(err) {
+			blocked(c)
+			return
+		}
 		if todo.IsInvalidInput(err) {
 			response.BadRequest(c, "Invalid input", err.Error())
 			return
@@ -147,35 +422,117 @@ func (h *Handler) Update(c *gin.Context) {
 		return
 	}
 
-	resp := TodoResponse{
-		ID:          result.ID,
-		Title:       result.Title,
-		Description: result.Description,
-		Completed:   result.Completed,
-		CreatedAt:   FormatTime(result.CreatedAt),
-		UpdatedAt:   FormatTime(result.UpdatedAt),
-	}
+	resp := NewTodoResponse(result)
 
+	c.Header("ETag", todoETag(result))
 	response.OK(c, "Todo updated successfully", resp)
 }
 
-// Delete handles DELETE /api/v1/todos/:id
+// Delete handles DELETE /api/v1/todos/:id by moving the todo to the trash
 func (h *Handler) Delete(c *gin.Context) {
-	idParam := c.Param("id")
-	id, err := strconv.ParseUint(idParam, 10, 32)
-	if err != nil {
-		response.BadRequest(c, "Invalid todo ID", "ID must be a positive integer")
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	version, ok := h.ifMatchVersion(c, userID, id)
+	if !ok {
 		return
 	}
 
-	if err := h.service.Delete(c.Request.Context(), uint(id)); err != nil {
+	if err := h.service.Delete(c.Request.Context(), userID, id, version); err != nil {
 		if todo.IsNotFound(err) {
 			response.NotFound(c, "Todo not found")
 			return
 		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsVersionConflict(err) {
+			versionConflict(c)
+			return
+		}
 		response.InternalServerError(c, "Failed to delete todo", err.Error())
 		return
 	}
 
-	response.OK(c, "Todo deleted successfully", nil)
+	response.OK(c, "Todo moved to trash", nil)
+}
+
+// AttachTags handles POST /api/v1/todos/:id/tags
+func (h *Handler) AttachTags(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req AttachTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	result, err := h.service.AttachTags(c.Request.Context(), userID, id, req.TagIDs)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo or tag not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to attach tags", err.Error())
+		return
+	}
+
+	response.OK(c, "Tags attached successfully", NewTodoResponse(result))
+}
+
+// DetachTag handles DELETE /api/v1/todos/:id/tags/:tagId
+func (h *Handler) DetachTag(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	tagID, ok := param.ID(c, "tagId", "tag")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DetachTag(c.Request.Context(), userID, id, tagID); err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo or tag not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		response.InternalServerError(c, "Failed to detach tag", err.Error())
+		return
+	}
+
+	response.OK(c, "Tag detached successfully", nil)
 }
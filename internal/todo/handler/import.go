@@ -0,0 +1,449 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/ical"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/arulkarim/golden-architecture/pkg/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// maxImportSize bounds the size of an import upload in bytes
+const maxImportSize = 10 << 20
+
+// importFields are the todo fields an import reads, named like the export columns
+var importFields = []string{
+	"title", "description", "completed", "priority", "due_at",
+	"completed_at", "tags", "recurrence", "timezone",
+}
+
+// importFormats infers the import format from the file extension
+var importFormats = map[string]string{
+	".csv":    "csv",
+	".json":   "json",
+	".jsonl":  "json",
+	".ndjson": "json",
+	".ics":    "ics",
+}
+
+// importTimeLayouts are the accepted date forms; all but the first are read
+// in the row's timezone
+var importTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// errTooManyRows is returned when an import file exceeds todo.MaxImportRows
+var errTooManyRows = fmt.Errorf("an import holds at most %d rows", todo.MaxImportRows)
+
+// importRow is a parsed row with the errors found while parsing it
+type importRow struct {
+	row    ImportTodoRow
+	errors map[string]string
+}
+
+// Import handles POST /todos/import
+func (h *Handler) Import(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.Error(c, http.StatusRequestEntityTooLarge, "Import file too large", err.Error())
+			return
+		}
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	var req ImportTodosRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	format := req.Format
+	if format == "" {
+		format = importFormats[strings.ToLower(filepath.Ext(header.Filename))]
+	}
+	if format == "" {
+		response.BadRequest(c, "Unknown import format", "set format to csv, json or ics")
+		return
+	}
+
+	mapping := make(map[string]string)
+	if req.Mapping != "" {
+		if err := json.Unmarshal([]byte(req.Mapping), &mapping); err != nil {
+			response.BadRequest(c, "Invalid mapping", err.Error())
+			return
+		}
+		for field := range mapping {
+			if !slices.Contains(importFields, field) {
+				response.BadRequest(c, "Invalid mapping", fmt.Sprintf("unknown field %q", field))
+				return
+			}
+		}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+	defer file.Close()
+
+	var rows []importRow
+	switch format {
+	case "csv":
+		rows, err = readCSVImport(file, mapping)
+	case "json":
+		rows, err = readJSONImport(file, mapping)
+	case "ics":
+		rows, err = readICalImport(file)
+	}
+	if err != nil {
+		response.BadRequest(c, "Invalid import file", err.Error())
+		return
+	}
+	if len(rows) == 0 {
+		response.BadRequest(c, "Invalid import file", "the file holds no todos")
+		return
+	}
+
+	// Rows failing validation are reported without reaching the service
+	inputs := make([]todo.ImportTodoInput, 0, len(rows))
+	indexes := make([]int, 0, len(rows))
+	for i := range rows {
+		if _, malformed := rows[i].errors["Row"]; !malformed {
+			if err := binding.Validator.ValidateStruct(&rows[i].row); err != nil {
+				for field, message := range validator.GetValidationErrors(err) {
+					rows[i].errors[field] = message
+				}
+			}
+		}
+		if len(rows[i].errors) == 0 {
+			inputs = append(inputs, newImportTodoInput(rows[i].row))
+			indexes = append(indexes, i)
+		}
+	}
+
+	var results []todo.ImportResult
+	if len(inputs) > 0 {
+		results, err = h.service.Import(c.Request.Context(), userID, inputs, req.DryRun)
+		if err != nil {
+			if todo.IsInvalidInput(err) {
+				response.BadRequest(c, "Invalid input", err.Error())
+				return
+			}
+			if todo.IsDuplicate(err) {
+				response.Conflict(c, "Tag already exists", "a tag named in the import was created meanwhile; retry the import")
+				return
+			}
+			response.InternalServerError(c, "Failed to import todos", err.Error())
+			return
+		}
+	}
+
+	resp := ImportResponse{
+		DryRun: req.DryRun,
+		Total:  len(rows),
+		Rows:   make([]ImportRowResponse, len(rows)),
+	}
+	for i, row := range rows {
+		resp.Rows[i] = ImportRowResponse{Row: i + 1, Status: todo.ImportInvalid, Errors: row.errors}
+	}
+	for j, result := range results {
+		item := &resp.Rows[indexes[j]]
+		item.Status = result.Status
+		if result.Err != nil {
+			item.Errors = map[string]string{"Row": result.Err.Error()}
+		}
+		if result.Status == todo.ImportDuplicate && result.DuplicateOf != 0 {
+			item.DuplicateOf = &result.DuplicateOf
+		}
+		if result.Status == todo.ImportCreated {
+			todoResp := NewTodoResponse(result.Todo)
+			item.Todo = &todoResp
+		}
+	}
+	for _, item := range resp.Rows {
+		switch item.Status {
+		case todo.ImportCreated:
+			resp.Created++
+		case todo.ImportValid:
+			resp.Valid++
+		case todo.ImportInvalid:
+			resp.Invalid++
+		case todo.ImportDuplicate:
+			resp.Duplicates++
+		}
+	}
+
+	if resp.Created > 0 {
+		response.Created(c, "Todos imported successfully", resp)
+		return
+	}
+	response.OK(c, "Import checked successfully", resp)
+}
+
+// readCSVImport reads an RFC 4180 CSV file with a header row
+func readCSVImport(r io.Reader, mapping map[string]string) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	index := make(map[string]int, len(importFields))
+	for _, field := range importFields {
+		source := field
+		if name, ok := mapping[field]; ok {
+			source = name
+		}
+		if i, ok := columns[strings.ToLower(strings.TrimSpace(source))]; ok {
+			index[field] = i
+		}
+	}
+	if _, ok := index["title"]; !ok {
+		return nil, errors.New("no title column; map one with mapping")
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == todo.MaxImportRows {
+			return nil, errTooManyRows
+		}
+
+		values := make(map[string]string, len(index))
+		for field, i := range index {
+			if i < len(record) {
+				values[field] = record[i]
+			}
+		}
+		rows = append(rows, newImportRow(values))
+	}
+}
+
+// readJSONImport reads a JSON Lines file of objects. Blank lines are skipped
+// and malformed lines are reported as invalid rows.
+func readJSONImport(r io.Reader, mapping map[string]string) ([]importRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []importRow
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if len(rows) == todo.MaxImportRows {
+			return nil, errTooManyRows
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &object); err != nil {
+			rows = append(rows, importRow{errors: map[string]string{"Row": "Invalid JSON object"}})
+			continue
+		}
+
+		values := make(map[string]string, len(importFields))
+		for _, field := range importFields {
+			source := field
+			if name, ok := mapping[field]; ok {
+				source = name
+			}
+			values[field] = jsonImportValue(object[source])
+		}
+		rows = append(rows, newImportRow(values))
+	}
+	return rows, scanner.Err()
+}
+
+// readICalImport reads the VTODO components of an iCalendar file
+func readICalImport(r io.Reader) ([]importRow, error) {
+	reader := ical.NewReader(r)
+
+	var rows []importRow
+	for {
+		vtodo, err := reader.ReadTodo()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == todo.MaxImportRows {
+			return nil, errTooManyRows
+		}
+
+		rows = append(rows, importRow{
+			row: ImportTodoRow{
+				Title:       strings.TrimSpace(vtodo.Summary),
+				Description: vtodo.Description,
+				Completed:   vtodo.Status == ical.StatusCompleted || vtodo.Completed != nil,
+				Priority:    importPriority(vtodo.Priority),
+				DueAt:       vtodo.Due,
+				CompletedAt: vtodo.Completed,
+				Tags:        vtodo.Categories,
+				Recurrence:  vtodo.RRule,
+				Timezone:    vtodo.Timezone,
+			},
+			errors: make(map[string]string),
+		})
+	}
+}
+
+// newImportRow parses the text values of a CSV or JSON row
+func newImportRow(values map[string]string) importRow {
+	parsed := importRow{
+		row: ImportTodoRow{
+			Title:       strings.TrimSpace(values["title"]),
+			Description: values["description"],
+			Priority:    strings.ToLower(strings.TrimSpace(values["priority"])),
+			Tags:        splitList(values["tags"]),
+			Recurrence:  strings.TrimSpace(values["recurrence"]),
+			Timezone:    strings.TrimSpace(values["timezone"]),
+		},
+		errors: make(map[string]string),
+	}
+
+	location, err := parseLocation(parsed.row.Timezone)
+	if err != nil {
+		parsed.errors["Timezone"] = "Invalid timezone"
+		location = time.UTC
+	}
+	if value := strings.TrimSpace(values["completed"]); value != "" {
+		if parsed.row.Completed, err = parseImportBool(value); err != nil {
+			parsed.errors["Completed"] = "Invalid value"
+		}
+	}
+	if parsed.row.DueAt, err = parseImportTime(values["due_at"], location); err != nil {
+		parsed.errors["DueAt"] = "Invalid date"
+	}
+	if parsed.row.CompletedAt, err = parseImportTime(values["completed_at"], location); err != nil {
+		parsed.errors["CompletedAt"] = "Invalid date"
+	}
+	return parsed
+}
+
+// newImportTodoInput maps a validated import row to service input
+func newImportTodoInput(row ImportTodoRow) todo.ImportTodoInput {
+	return todo.ImportTodoInput{
+		CreateTodoInput: todo.CreateTodoInput{
+			Title:       row.Title,
+			Description: row.Description,
+			Priority:    entity.TodoPriority(row.Priority),
+			DueAt:       row.DueAt,
+			Recurrence:  row.Recurrence,
+			Timezone:    row.Timezone,
+		},
+		Completed:   row.Completed || row.CompletedAt != nil,
+		CompletedAt: row.CompletedAt,
+		Tags:        row.Tags,
+	}
+}
+
+// jsonImportValue formats a decoded JSON value as import text. Arrays, such
+// as tags, become comma-separated lists.
+func jsonImportValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, jsonImportValue(item))
+		}
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parseImportBool parses a boolean, also accepting yes and no
+func parseImportBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "yes", "y":
+		return true, nil
+	case "no", "n":
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// parseImportTime parses an optional date, reading dates without an offset
+// in the given location
+func parseImportTime(value string, location *time.Location) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range importTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date %q", value)
+}
+
+// importPriority maps an iCalendar PRIORITY to a todo priority. Undefined
+// priorities keep the default.
+func importPriority(priority int) string {
+	switch {
+	case priority == 0:
+		return ""
+	case priority <= 2:
+		return string(entity.PriorityUrgent)
+	case priority <= 4:
+		return string(entity.PriorityHigh)
+	case priority == 5:
+		return string(entity.PriorityMedium)
+	default:
+		return string(entity.PriorityLow)
+	}
+}
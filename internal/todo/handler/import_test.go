@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// repeatLines returns n copies of line, each ending in a newline
+func repeatLines(line string, n int) string {
+	return strings.Repeat(line+"\n", n)
+}
+
+// wantImportRow is the part of a parsed row the import tests compare
+type wantImportRow struct {
+	title     string
+	completed bool
+	dueAt     *time.Time
+	tags      []string
+	errors    map[string]string
+}
+
+// checkImportRows compares parsed rows with the expected ones
+func checkImportRows(t *testing.T, got []importRow, want []wantImportRow) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("read %d rows, want %d", len(got), len(want))
+	}
+	for i, row := range got {
+		w := want[i]
+		if row.row.Title != w.title || row.row.Completed != w.completed {
+			t.Errorf("row %d = %q/%v, want %q/%v", i, row.row.Title, row.row.Completed, w.title, w.completed)
+		}
+		if (row.row.DueAt == nil) != (w.dueAt == nil) || (w.dueAt != nil && !row.row.DueAt.Equal(*w.dueAt)) {
+			t.Errorf("row %d due_at = %v, want %v", i, row.row.DueAt, w.dueAt)
+		}
+		if !reflect.DeepEqual(row.row.Tags, w.tags) {
+			t.Errorf("row %d tags = %v, want %v", i, row.row.Tags, w.tags)
+		}
+		if len(row.errors) != len(w.errors) || (len(w.errors) > 0 && !reflect.DeepEqual(row.errors, w.errors)) {
+			t.Errorf("row %d errors = %v, want %v", i, row.errors, w.errors)
+		}
+	}
+}
+
+func TestReadCSVImport(t *testing.T) {
+	due := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		mapping map[string]string
+		want    []wantImportRow
+		wantErr error
+	}{
+		{
+			name:  "header with byte order mark, any case and order",
+			input: "\ufeffTags, Title ,completed,due_at\n\"home, errands\",Buy milk,yes,2026-03-01T09:30:00Z\n",
+			want: []wantImportRow{
+				{title: "Buy milk", completed: true, dueAt: &due, tags: []string{"home", "errands"}},
+			},
+		},
+		{
+			name:    "mapped column",
+			input:   "Name,Done\nBuy milk,true\n",
+			mapping: map[string]string{"title": "name", "completed": "Done"},
+			want:    []wantImportRow{{title: "Buy milk", completed: true}},
+		},
+		{
+			name:  "quoted field spanning lines",
+			input: "title,description\n\"Buy\nmilk\",x\n",
+			want:  []wantImportRow{{title: "Buy\nmilk"}},
+		},
+		{
+			name:  "date without an offset in the row's timezone",
+			input: "title,due_at,timezone\nPay rent,2026-03-01,UTC\n",
+			want:  []wantImportRow{{title: "Pay rent", dueAt: &day}},
+		},
+		{
+			name:  "bad values",
+			input: "title,completed,due_at,completed_at,timezone\nBuy milk,maybe,01/03/2026,never,Mars/Olympus\n",
+			want: []wantImportRow{{
+				title: "Buy milk",
+				errors: map[string]string{
+					"Completed":   "Invalid value",
+					"DueAt":       "Invalid date",
+					"CompletedAt": "Invalid date",
+					"Timezone":    "Invalid timezone",
+				},
+			}},
+		},
+		{
+			name:  "short record and missing title",
+			input: "title,description\n\nBuy milk\n,no title\n",
+			want:  []wantImportRow{{title: "Buy milk"}, {title: ""}},
+		},
+		{
+			name:  "header only",
+			input: "title\n",
+		},
+		{
+			name: "empty file",
+		},
+		{
+			name:    "no title column",
+			input:   "name\nBuy milk\n",
+			wantErr: errors.New("no title column"),
+		},
+		{
+			name:    "malformed quoting",
+			input:   "title\n\"Buy milk\n",
+			wantErr: errors.New("extraneous or missing"),
+		},
+		{
+			name:    "too many rows",
+			input:   "title\n" + repeatLines("Buy milk", todo.MaxImportRows+1),
+			wantErr: errTooManyRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := readCSVImport(strings.NewReader(tt.input), tt.mapping)
+			if tt.wantErr != nil {
+				if err == nil || !(errors.Is(err, tt.wantErr) || strings.Contains(err.Error(), tt.wantErr.Error())) {
+					t.Fatalf("readCSVImport() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readCSVImport() unexpected error: %v", err)
+			}
+			checkImportRows(t, rows, tt.want)
+		})
+	}
+}
+
+func TestReadJSONImport(t *testing.T) {
+	due := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		mapping map[string]string
+		want    []wantImportRow
+		wantErr error
+	}{
+		{
+			name: "values of any JSON type",
+			input: `{"title":"Buy milk","completed":true,"due_at":"2026-03-01T09:30:00Z","tags":["home","errands"]}` + "\n" +
+				`{"title":"Call","priority":"high","tags":"work"}` + "\n",
+			want: []wantImportRow{
+				{title: "Buy milk", completed: true, dueAt: &due, tags: []string{"home", "errands"}},
+				{title: "Call", tags: []string{"work"}},
+			},
+		},
+		{
+			name:    "mapped field",
+			input:   `{"name":"Buy milk"}`,
+			mapping: map[string]string{"title": "name"},
+			want:    []wantImportRow{{title: "Buy milk"}},
+		},
+		{
+			name:  "blank lines are skipped",
+			input: "\n" + `{"title":"Buy milk"}` + "\n   \n",
+			want:  []wantImportRow{{title: "Buy milk"}},
+		},
+		{
+			name:  "malformed line is an invalid row",
+			input: `{"title":"Buy milk"` + "\n" + `["Call"]` + "\n" + `{"title":"Call"}`,
+			want: []wantImportRow{
+				{errors: map[string]string{"Row": "Invalid JSON object"}},
+				{errors: map[string]string{"Row": "Invalid JSON object"}},
+				{title: "Call"},
+			},
+		},
+		{
+			name:  "bad date and missing title",
+			input: `{"due_at":"tomorrow"}`,
+			want:  []wantImportRow{{errors: map[string]string{"DueAt": "Invalid date"}}},
+		},
+		{
+			name:    "too many rows",
+			input:   repeatLines(`{"title":"Buy milk"}`, todo.MaxImportRows+1),
+			wantErr: errTooManyRows,
+		},
+		{
+			name:    "oversized line",
+			input:   fmt.Sprintf(`{"title":%q}`, strings.Repeat("a", 1024*1024)),
+			wantErr: errors.New("token too long"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := readJSONImport(strings.NewReader(tt.input), tt.mapping)
+			if tt.wantErr != nil {
+				if err == nil || !(errors.Is(err, tt.wantErr) || strings.Contains(err.Error(), tt.wantErr.Error())) {
+					t.Fatalf("readJSONImport() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readJSONImport() unexpected error: %v", err)
+			}
+			checkImportRows(t, rows, tt.want)
+		})
+	}
+}
+
+func TestImportTodoRowValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		row     ImportTodoRow
+		wantErr bool
+	}{
+		{name: "valid", row: ImportTodoRow{Title: "Buy milk", Priority: "high", Tags: []string{"home"}}},
+		{name: "missing title", row: ImportTodoRow{}, wantErr: true},
+		{name: "title too long", row: ImportTodoRow{Title: strings.Repeat("a", 256)}, wantErr: true},
+		{name: "unknown priority", row: ImportTodoRow{Title: "Buy milk", Priority: "asap"}, wantErr: true},
+		{name: "empty tag", row: ImportTodoRow{Title: "Buy milk", Tags: []string{""}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := binding.Validator.ValidateStruct(&tt.row)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStruct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
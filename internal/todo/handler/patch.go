@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/jsonpatch"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// Media types accepted by PATCH /api/v1/todos/:id
+const (
+	mediaTypeMergePatch = "application/merge-patch+json"
+	mediaTypeJSONPatch  = "application/json-patch+json"
+)
+
+// errInvalidPatchedTodo is returned when a patch leaves the todo invalid
+var errInvalidPatchedTodo = errors.New("patched todo is invalid")
+
+// Patch handles PATCH /api/v1/todos/:id.
+// The patch is applied to the TodoPatchDocument of the todo, and the result
+// must pass the same validation as an update.
+func (h *Handler) Patch(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	var apply func(doc []byte) ([]byte, error)
+	switch c.ContentType() {
+	case mediaTypeMergePatch:
+		apply = func(doc []byte) ([]byte, error) {
+			return jsonpatch.MergePatch(doc, body)
+		}
+	case mediaTypeJSONPatch:
+		patch, err := jsonpatch.DecodePatch(body)
+		if err != nil {
+			response.BadRequest(c, "Invalid JSON Patch", err.Error())
+			return
+		}
+		apply = patch.Apply
+	default:
+		response.UnsupportedMediaType(c, "Unsupported patch format",
+			"Content-Type must be "+mediaTypeMergePatch+" or "+mediaTypeJSONPatch)
+		return
+	}
+
+	options, ok := writeOptions(c)
+	if !ok {
+		return
+	}
+
+	version, ok := h.ifMatchVersion(c, userID, id)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Patch(c.Request.Context(), userID, id, version, func(current *entity.Todo) (todo.UpdateTodoInput, error) {
+		input, err := patchTodo(current, apply)
+		input.Force = options.Force
+		return input, err
+	})
+	if err != nil {
+		switch {
+		case todo.IsNotFound(err):
+			response.NotFound(c, "Todo not found")
+		case todo.IsForbidden(err):
+			auth.ReadOnly(c)
+		case todo.IsVersionConflict(err):
+			versionConflict(c)
+		case todo.IsTransitionNotAllowed(err):
+			response.Conflict(c, "Status transition not allowed", err.Error())
+		case todo.IsBlocked(err):
+			blocked(c)
+		case errors.Is(err, jsonpatch.ErrTestFailed):
+			response.Conflict(c, "Patch test failed", err.Error())
+		case errors.Is(err, jsonpatch.ErrInvalidPatch):
+			response.BadRequest(c, "Invalid patch", err.Error())
+		case errors.Is(err, jsonpatch.ErrPathNotFound),
+			errors.Is(err, errInvalidPatchedTodo),
+			todo.IsInvalidInput(err):
+			response.UnprocessableEntity(c, "Patch cannot be applied", err.Error())
+		default:
+			response.InternalServerError(c, "Failed to patch todo", err.Error())
+		}
+		return
+	}
+
+	resp := NewTodoResponse(result)
+
+	c.Header("ETag", todoETag(result))
+	response.OK(c, "Todo patched successfully", resp)
+}
+
+// patchTodo applies a patch to the document of a todo and returns the
+// update that turns the todo into the patched document
+func patchTodo(current *entity.Todo, apply func(doc []byte) ([]byte, error)) (todo.UpdateTodoInput, error) {
+	doc, err := json.Marshal(NewTodoPatchDocument(current))
+	if err != nil {
+		return todo.UpdateTodoInput{}, err
+	}
+
+	patched, err := apply(doc)
+	if err != nil {
+		return todo.UpdateTodoInput{}, err
+	}
+
+	var result TodoPatchDocument
+	if err := decodeStrict(patched, &result); err != nil {
+		return todo.UpdateTodoInput{}, fmt.Errorf("%w: %v", errInvalidPatchedTodo, err)
+	}
+	if err := binding.Validator.ValidateStruct(&result); err != nil {
+		return todo.UpdateTodoInput{}, fmt.Errorf("%w: %v", errInvalidPatchedTodo, err)
+	}
+
+	priority := entity.TodoPriority(result.Priority)
+	input := todo.UpdateTodoInput{
+		Title:        &result.Title,
+		Description:  &result.Description,
+		Priority:     &priority,
+		DueAt:        result.DueAt,
+		ClearDueAt:   result.DueAt == nil,
+		AutoComplete: &result.AutoComplete,
+		Recurrence:   &result.Recurrence,
+		Timezone:     &result.Timezone,
+	}
+	// Only pass on the status fields the patch changed, so that changing
+	// one of them does not conflict with the unchanged other
+	if result.Completed != current.Completed {
+		input.Completed = &result.Completed
+	}
+	if result.Status != current.Status {
+		input.Status = &result.Status
+	}
+	return input, nil
+}
+
+// NewTodoPatchDocument maps a todo entity to its editable document.
+// Due dates are truncated to seconds to match their RFC3339 form in responses.
+func NewTodoPatchDocument(t *entity.Todo) TodoPatchDocument {
+	doc := TodoPatchDocument{
+		Title:        t.Title,
+		Description:  t.Description,
+		Completed:    t.Completed,
+		Status:       t.Status,
+		Priority:     string(t.Priority),
+		AutoComplete: t.AutoComplete,
+		Recurrence:   t.Recurrence,
+		Timezone:     t.Timezone,
+	}
+	if t.DueAt != nil {
+		dueAt := t.DueAt.Truncate(time.Second)
+		doc.DueAt = &dueAt
+	}
+	return doc
+}
+
+// decodeStrict decodes JSON into v, rejecting unknown fields
+func decodeStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
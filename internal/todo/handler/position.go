@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Reorder handles POST /api/v1/todos/:id/move
+func (h *Handler) Reorder(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req ReorderTodoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	version, ok := h.ifMatchVersion(c, userID, id)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Reorder(c.Request.Context(), userID, id, req.Before, req.After, version)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			auth.ReadOnly(c)
+			return
+		}
+		if todo.IsVersionConflict(err) {
+			versionConflict(c)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", "before and after must be other todos, with after ranked in front of before")
+			return
+		}
+		response.InternalServerError(c, "Failed to move todo", err.Error())
+		return
+	}
+
+	c.Header("ETag", todoETag(result))
+	response.OK(c, "Todo moved successfully", NewTodoResponse(result))
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// GetAllByProject handles GET /api/v1/projects/:id/todos
+func (h *Handler) GetAllByProject(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	projectID, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	var req ListProjectTodosRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	input, err := filterInput(req.TodoFilterRequest)
+	if err != nil {
+		response.BadRequest(c, "Invalid timezone", err.Error())
+		return
+	}
+	input.Page = req.Page
+	input.PageSize = req.PageSize
+	input.SortBy = req.Sort
+	input.SortOrder = req.Order
+
+	page, err := h.service.GetAllByProject(c.Request.Context(), userID, projectID, input)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid query parameters", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get todos", err.Error())
+		return
+	}
+
+	resp := TodoListResponse{
+		Todos:      NewTodoResponses(page.Todos),
+		Total:      page.Total,
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		TotalPages: page.TotalPages(),
+	}
+
+	response.OK(c, "Todos retrieved successfully", resp)
+}
+
+// Move handles PUT /api/v1/todos/:id/project
+func (h *Handler) Move(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	var req MoveTodoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+
+	version, ok := h.ifMatchVersion(c, userID, id)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Move(c.Request.Context(), userID, id, req.ProjectID, version)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Todo or project not found")
+			return
+		}
+		if todo.IsForbidden(err) {
+			response.Forbidden(c, "Forbidden", "moving requires the editor role in both projects")
+			return
+		}
+		if todo.IsVersionConflict(err) {
+			versionConflict(c)
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", "todos cannot be moved to an archived project")
+			return
+		}
+		response.InternalServerError(c, "Failed to move todo", err.Error())
+		return
+	}
+
+	c.Header("ETag", todoETag(result))
+	response.OK(c, "Todo moved successfully", NewTodoResponse(result))
+}
+
+// Board handles GET /api/v1/projects/:id/board
+func (h *Handler) Board(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	projectID, ok := param.ID(c, "id", "project")
+	if !ok {
+		return
+	}
+
+	var req BoardRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	board, err := h.service.Board(c.Request.Context(), userID, projectID, req.Limit)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Project not found")
+			return
+		}
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid query parameters", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get board", err.Error())
+		return
+	}
+
+	response.OK(c, "Board retrieved successfully", newBoardResponse(board))
+}
+
+// newBoardResponse maps a board to its response
+func newBoardResponse(board *todo.Board) BoardResponse {
+	resp := BoardResponse{
+		ProjectID: board.Project.ID,
+		Columns:   make([]BoardColumnResponse, 0, len(board.Columns)),
+	}
+	for _, column := range board.Columns {
+		resp.Columns = append(resp.Columns, BoardColumnResponse{
+			Key:   column.Status.Key,
+			Name:  column.Status.Name,
+			Done:  column.Status.Done,
+			Total: column.Total,
+			Todos: NewTodoResponses(column.Todos),
+		})
+	}
+	return resp
+}
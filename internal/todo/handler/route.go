@@ -1,17 +1,55 @@
 package handler
 
 import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterRoutes registers todo routes
-func RegisterRoutes(router *gin.RouterGroup, handler *Handler) {
-	todos := router.Group("/todos")
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, jwtManager *auth.JWTManager) {
+	// All todo routes are protected; todos are scoped to the authenticated user
+	todos := router.Group("/todos", auth.AuthMiddleware(jwtManager))
 	{
 		todos.POST("", handler.Create)
 		todos.GET("", handler.GetAll)
+		todos.POST("/bulk", handler.Bulk)
+		todos.GET("/search", handler.Search)
+		todos.GET("/export", handler.Export)
+		todos.POST("/import", handler.Import)
+		todos.GET("/trash", handler.ListTrash)
+		todos.DELETE("/trash/:id", handler.DeletePermanently)
 		todos.GET("/:id", handler.GetByID)
 		todos.PUT("/:id", handler.Update)
+		todos.PATCH("/:id", handler.Patch)
 		todos.DELETE("/:id", handler.Delete)
+		todos.POST("/:id/restore", handler.Restore)
+		todos.GET("/:id/history", handler.History)
+		todos.POST("/:id/revert", handler.Revert)
+		todos.PUT("/:id/project", handler.Move)
+		todos.POST("/:id/move", handler.Reorder)
+		todos.GET("/:id/occurrences", handler.Occurrences)
+		todos.POST("/:id/tags", handler.AttachTags)
+		todos.DELETE("/:id/tags/:tagId", handler.DetachTag)
+
+		// Checklist items
+		todos.GET("/:id/items", handler.ListItems)
+		todos.POST("/:id/items", handler.CreateItem)
+		todos.PUT("/:id/items/reorder", handler.ReorderItems)
+		todos.PUT("/:id/items/:itemId", handler.UpdateItem)
+		todos.DELETE("/:id/items/:itemId", handler.DeleteItem)
+		todos.POST("/:id/items/:itemId/complete", handler.CompleteItem)
+		todos.POST("/:id/items/:itemId/reopen", handler.ReopenItem)
+
+		// Dependencies
+		todos.GET("/:id/dependencies", handler.ListDependencies)
+		todos.POST("/:id/dependencies", handler.CreateDependency)
+		todos.DELETE("/:id/dependencies/:blockerId", handler.DeleteDependency)
+	}
+
+	// Todos nested under their project
+	projects := router.Group("/projects", auth.AuthMiddleware(jwtManager))
+	{
+		projects.GET("/:id/todos", handler.GetAllByProject)
+		projects.GET("/:id/board", handler.Board)
 	}
 }
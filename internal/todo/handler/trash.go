@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/auth"
+	"github.com/arulkarim/golden-architecture/internal/todo"
+	"github.com/arulkarim/golden-architecture/pkg/param"
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ListTrash handles GET /api/v1/todos/trash
+func (h *Handler) ListTrash(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req ListTrashRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	result, err := h.service.ListTrash(c.Request.Context(), userID, todo.ListTrashInput{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		if todo.IsInvalidInput(err) {
+			response.BadRequest(c, "Invalid input", err.Error())
+			return
+		}
+		response.InternalServerError(c, "Failed to get trashed todos", err.Error())
+		return
+	}
+
+	resp := TodoListResponse{
+		Todos:      NewTodoResponses(result.Todos),
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages(),
+	}
+
+	response.OK(c, "Trashed todos retrieved successfully", resp)
+}
+
+// Restore handles POST /api/v1/todos/:id/restore
+func (h *Handler) Restore(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Restore(c.Request.Context(), userID, id)
+	if err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Trashed todo not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to restore todo", err.Error())
+		return
+	}
+
+	response.OK(c, "Todo restored successfully", NewTodoResponse(result))
+}
+
+// DeletePermanently handles DELETE /api/v1/todos/trash/:id
+func (h *Handler) DeletePermanently(c *gin.Context) {
+	userID, ok := auth.CurrentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := param.ID(c, "id", "todo")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeletePermanently(c.Request.Context(), userID, id); err != nil {
+		if todo.IsNotFound(err) {
+			response.NotFound(c, "Trashed todo not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to delete todo permanently", err.Error())
+		return
+	}
+
+	response.OK(c, "Todo deleted permanently", nil)
+}
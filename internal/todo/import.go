@@ -0,0 +1,204 @@
+package todo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/tag"
+)
+
+const (
+	// MaxImportRows is the largest number of rows a single import may hold
+	MaxImportRows = 5000
+	// ImportBatchSize is the number of todos inserted per statement
+	ImportBatchSize = 100
+)
+
+// Import row statuses
+const (
+	ImportCreated   = "created"
+	ImportValid     = "valid"
+	ImportInvalid   = "invalid"
+	ImportDuplicate = "duplicate"
+)
+
+// ImportTodoInput represents one row of an import. Tags are matched by
+// name; missing ones are created through the tag service.
+type ImportTodoInput struct {
+	CreateTodoInput
+	Completed   bool
+	CompletedAt *time.Time
+	Tags        []string
+}
+
+// ImportResult is the outcome of one import row. Valid rows are only
+// reported by dry runs. DuplicateOf is the existing todo a duplicate row
+// matches, or zero when it repeats an earlier row of the import.
+type ImportResult struct {
+	Status      string
+	Todo        *entity.Todo
+	DuplicateOf uint
+	Err         error
+}
+
+// importKey identifies duplicates by title and due date
+type importKey struct {
+	title string
+	due   int64
+	isDue bool
+}
+
+// newImportKey builds the duplicate key of a todo
+func newImportKey(title string, dueAt *time.Time) importKey {
+	key := importKey{title: title}
+	if dueAt != nil {
+		key.due, key.isDue = dueAt.Unix(), true
+	}
+	return key
+}
+
+// Import creates todos from imported rows, returning one result per row.
+// Invalid rows and rows duplicating an existing todo or an earlier row
+// (same title and due date) are skipped. The remaining rows are inserted in
+// batches within one transaction; a dry run validates without writing.
+func (s *Service) Import(ctx context.Context, userID uint, rows []ImportTodoInput, dryRun bool) ([]ImportResult, error) {
+	if userID == 0 || len(rows) == 0 || len(rows) > MaxImportRows {
+		return nil, domain.ErrInvalidInput
+	}
+
+	now := time.Now()
+	rows = slices.Clone(rows)
+	results := make([]ImportResult, len(rows))
+	titles := make([]string, 0, len(rows))
+	for i, row := range rows {
+		todo, err := newTodo(userID, row.CreateTodoInput)
+		if err != nil {
+			results[i] = ImportResult{Status: ImportInvalid, Err: err}
+			continue
+		}
+		rows[i].Tags, err = tagNames(row.Tags)
+		if err != nil {
+			results[i] = ImportResult{Status: ImportInvalid, Err: err}
+			continue
+		}
+		if row.Completed {
+			setStatus(todo, entity.DefaultWorkflow().DoneStatus(), now)
+			if row.CompletedAt != nil {
+				todo.CompletedAt = row.CompletedAt
+			}
+		}
+
+		results[i] = ImportResult{Status: ImportValid, Todo: todo}
+		titles = append(titles, todo.Title)
+	}
+
+	existing, err := s.repo.FindByTitles(ctx, userID, titles)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[importKey]uint, len(existing)+len(rows))
+	for _, todo := range existing {
+		seen[newImportKey(todo.Title, todo.DueAt)] = todo.ID
+	}
+	for i := range results {
+		if results[i].Status != ImportValid {
+			continue
+		}
+		key := newImportKey(results[i].Todo.Title, results[i].Todo.DueAt)
+		if id, ok := seen[key]; ok {
+			results[i] = ImportResult{Status: ImportDuplicate, DuplicateOf: id}
+			continue
+		}
+		seen[key] = 0
+	}
+
+	if dryRun {
+		return results, nil
+	}
+
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.writeImport(ctx, userID, rows, results)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// writeImport inserts the valid rows in batches and attaches their tags
+func (s *Service) writeImport(ctx context.Context, userID uint, rows []ImportTodoInput, results []ImportResult) error {
+	tags, err := s.importTags(ctx, userID, rows, results)
+	if err != nil {
+		return err
+	}
+
+	var pending []int
+	for i := range results {
+		if results[i].Status == ImportValid {
+			pending = append(pending, i)
+		}
+	}
+
+	for start := 0; start < len(pending); start += ImportBatchSize {
+		batch := pending[start:min(start+ImportBatchSize, len(pending))]
+		todos := make([]entity.Todo, 0, len(batch))
+		for _, i := range batch {
+			todos = append(todos, *results[i].Todo)
+		}
+		if err := s.repo.CreateBatch(ctx, todos); err != nil {
+			return err
+		}
+
+		for j, i := range batch {
+			todo := &todos[j]
+			var tagIDs []uint
+			for _, name := range rows[i].Tags {
+				if tag, ok := tags[name]; ok && !slices.ContainsFunc(todo.Tags, func(t entity.Tag) bool { return t.ID == tag.ID }) {
+					todo.Tags = append(todo.Tags, tag)
+					tagIDs = append(tagIDs, tag.ID)
+				}
+			}
+			if err := s.repo.AttachTags(ctx, todo.ID, tagIDs); err != nil {
+				return err
+			}
+
+			results[i] = ImportResult{Status: ImportCreated, Todo: todo}
+		}
+
+		if err := s.recordAll(ctx, userID, entity.TodoCreated, nil, todos); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importTags resolves the tag names used by valid rows, creating the
+// user's missing tags
+func (s *Service) importTags(ctx context.Context, userID uint, rows []ImportTodoInput, results []ImportResult) (map[string]entity.Tag, error) {
+	var names []string
+	for i, row := range rows {
+		if results[i].Status == ImportValid {
+			names = append(names, row.Tags...)
+		}
+	}
+	return s.tagService.FindOrCreate(ctx, userID, names)
+}
+
+// tagNames normalizes the tag names given with a new todo the way the tag
+// service does, dropping repeated ones
+func tagNames(names []string) ([]string, error) {
+	tags := make([]string, 0, len(names))
+	for _, name := range names {
+		name, err := tag.NormalizeName(name)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(tags, name) {
+			tags = append(tags, name)
+		}
+	}
+	return tags, nil
+}
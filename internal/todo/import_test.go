@@ -0,0 +1,109 @@
+package todo
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func (f *fakeTodos) FindByTitles(_ context.Context, userID uint, titles []string) ([]entity.Todo, error) {
+	var found []entity.Todo
+	for _, todo := range f.todos {
+		if todo.UserID == userID && slices.Contains(titles, todo.Title) {
+			found = append(found, todo)
+		}
+	}
+	return found, nil
+}
+
+func TestImportDryRun(t *testing.T) {
+	due := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	otherDue := due.Add(24 * time.Hour)
+	todos := &fakeTodos{todos: []entity.Todo{
+		{ID: 7, UserID: 1, Title: "Pay rent", DueAt: &due},
+		{ID: 8, UserID: 2, Title: "Buy milk"},
+	}}
+
+	tests := []struct {
+		name    string
+		rows    []ImportTodoInput
+		want    []ImportResult
+		wantErr bool
+	}{
+		{
+			name: "valid rows",
+			rows: []ImportTodoInput{
+				{CreateTodoInput: CreateTodoInput{Title: "Buy milk"}, Tags: []string{"Home", "home"}},
+				{CreateTodoInput: CreateTodoInput{Title: "Pay rent", DueAt: &otherDue}},
+			},
+			want: []ImportResult{{Status: ImportValid}, {Status: ImportValid}},
+		},
+		{
+			name: "duplicate of an existing todo",
+			rows: []ImportTodoInput{{CreateTodoInput: CreateTodoInput{Title: "Pay rent", DueAt: &due}}},
+			want: []ImportResult{{Status: ImportDuplicate, DuplicateOf: 7}},
+		},
+		{
+			name: "duplicate of an earlier row",
+			rows: []ImportTodoInput{
+				{CreateTodoInput: CreateTodoInput{Title: "Call"}},
+				{CreateTodoInput: CreateTodoInput{Title: "Call"}},
+				{CreateTodoInput: CreateTodoInput{Title: "Call", DueAt: &due}},
+			},
+			want: []ImportResult{{Status: ImportValid}, {Status: ImportDuplicate}, {Status: ImportValid}},
+		},
+		{
+			name: "invalid rows are not duplicates",
+			rows: []ImportTodoInput{
+				{CreateTodoInput: CreateTodoInput{Title: "Call", Recurrence: "FREQ=DAILY"}},
+				{CreateTodoInput: CreateTodoInput{Title: "Call"}, Tags: []string{" "}},
+				{CreateTodoInput: CreateTodoInput{Title: "Call"}},
+			},
+			want: []ImportResult{{Status: ImportInvalid}, {Status: ImportInvalid}, {Status: ImportValid}},
+		},
+		{
+			name:    "no rows",
+			wantErr: true,
+		},
+		{
+			name:    "too many rows",
+			rows:    make([]ImportTodoInput, MaxImportRows+1),
+			wantErr: true,
+		},
+	}
+
+	s := NewService(Deps{Todos: todos})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := s.Import(context.Background(), 1, tt.rows, true)
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("Import() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Import() unexpected error: %v", err)
+			}
+
+			if len(results) != len(tt.want) {
+				t.Fatalf("Import() returned %d results, want %d", len(results), len(tt.want))
+			}
+			for i, result := range results {
+				if result.Status != tt.want[i].Status || result.DuplicateOf != tt.want[i].DuplicateOf {
+					t.Errorf("row %d = %s (duplicate of %d), want %s (duplicate of %d)",
+						i, result.Status, result.DuplicateOf, tt.want[i].Status, tt.want[i].DuplicateOf)
+				}
+				if result.Status == ImportInvalid && result.Err == nil {
+					t.Errorf("row %d is invalid without an error", i)
+				}
+				if result.Status == ImportValid && result.Todo.Status != entity.StatusBacklog {
+					t.Errorf("row %d status = %q, want %q", i, result.Todo.Status, entity.StatusBacklog)
+				}
+			}
+		})
+	}
+}
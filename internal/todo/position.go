@@ -0,0 +1,153 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/pkg/rank"
+)
+
+// Reorder moves a todo the user may change within its list: right in
+// front of the todo before, right behind the todo after, or between the
+// two when both are set, which must then be neighbours. The anchors must
+// be in the same list as the todo. Only the moved todo is rewritten, and
+// the move is not recorded in its history. When version is set the todo
+// is only moved at that version.
+func (s *Service) Reorder(ctx context.Context, userID, id uint, before, after, version *uint) (*entity.Todo, error) {
+	if userID == 0 || id == 0 || (before == nil && after == nil) {
+		return nil, domain.ErrInvalidInput
+	}
+	for _, anchor := range []*uint{before, after} {
+		if anchor != nil && (*anchor == 0 || *anchor == id) {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+
+	var todo *entity.Todo
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		todo, err = s.access.FindWritable(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if version != nil && *version != todo.Version {
+			return domain.ErrVersionConflict
+		}
+
+		list := contract.TodoListOf(todo)
+		if err := s.repo.LockList(ctx, list); err != nil {
+			return err
+		}
+		lower, upper, err := s.gap(ctx, userID, list, id, before, after)
+		if err != nil {
+			return err
+		}
+		position, err := rank.Between(lower, upper)
+		if err != nil {
+			return domain.ErrInvalidInput
+		}
+
+		todo.Position = position
+		return s.repo.Reposition(ctx, todo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// gap returns the positions a todo moved next to the anchors must fall
+// between. An empty bound is open.
+func (s *Service) gap(ctx context.Context, userID uint, list contract.TodoList, id uint, before, after *uint) (string, string, error) {
+	var lower, upper string
+	var err error
+	if after != nil {
+		if lower, err = s.anchorPosition(ctx, userID, list, *after); err != nil {
+			return "", "", err
+		}
+	}
+	if before != nil {
+		if upper, err = s.anchorPosition(ctx, userID, list, *before); err != nil {
+			return "", "", err
+		}
+	}
+
+	switch {
+	case before == nil:
+		upper, err = s.repo.AdjacentPosition(ctx, list, lower, id, true)
+	case after == nil:
+		lower, err = s.repo.AdjacentPosition(ctx, list, upper, id, false)
+	default:
+		var next string
+		next, err = s.repo.AdjacentPosition(ctx, list, lower, id, true)
+		if err == nil && next != upper {
+			err = fmt.Errorf("%w: todos %d and %d are not next to each other", domain.ErrInvalidInput, *after, *before)
+		}
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return lower, upper, nil
+}
+
+// anchorPosition returns the position of a todo a move is anchored to,
+// which must be in the given list
+func (s *Service) anchorPosition(ctx context.Context, userID uint, list contract.TodoList, id uint) (string, error) {
+	anchor, err := s.repo.FindPosition(ctx, userID, id)
+	if err != nil {
+		return "", err
+	}
+	if !sameList(contract.TodoListOf(anchor), list) {
+		return "", fmt.Errorf("%w: todo %d is in another list", domain.ErrInvalidInput, id)
+	}
+	return anchor.Position, nil
+}
+
+// moveToList moves a todo to the list of a project, or to its owner's
+// inbox when projectID is nil, placing it after the todos already there.
+// The caller writes the todo.
+func (s *Service) moveToList(ctx context.Context, todo *entity.Todo, projectID *uint) error {
+	list := contract.TodoListOf(todo)
+	todo.ProjectID = projectID
+	if sameList(contract.TodoListOf(todo), list) {
+		return nil
+	}
+	return s.repo.AppendPosition(ctx, todo)
+}
+
+// RebalancePositions gives the todos of every list holding a position
+// longer than maxLength new, short positions in their current order, one
+// list per transaction. It returns the number of todos rewritten.
+func (s *Service) RebalancePositions(ctx context.Context, maxLength int) (int, error) {
+	lists, err := s.repo.ListsOverLength(ctx, maxLength)
+	if err != nil {
+		return 0, err
+	}
+
+	var rebalanced int
+	for _, list := range lists {
+		var n int
+		err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			n, err = s.repo.Rebalance(ctx, list)
+			return err
+		})
+		if err != nil {
+			return rebalanced, err
+		}
+		rebalanced += n
+	}
+	return rebalanced, nil
+}
+
+// sameList reports whether two lists are the same
+func sameList(a, b contract.TodoList) bool {
+	if a.ProjectID != nil || b.ProjectID != nil {
+		return a.ProjectID != nil && b.ProjectID != nil && *a.ProjectID == *b.ProjectID
+	}
+	return a.UserID == b.UserID
+}
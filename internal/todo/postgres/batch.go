@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+)
+
+// FindInBatches walks the matching todos by ID, one keyset query per batch
+func (r *todoRepository) FindInBatches(ctx context.Context, filter contract.TodoFilter, batchSize int, fn func([]entity.Todo) error) error {
+	var lastID uint
+	for {
+		var todos []entity.Todo
+		result := database.Conn(ctx, r.db).
+			Scopes(filtered(filter)).
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(batchSize).
+			Find(&todos)
+		if result.Error != nil {
+			return domain.ErrDatabaseOperation
+		}
+		if len(todos) == 0 {
+			return nil
+		}
+		if err := r.hydrate(ctx, todos); err != nil {
+			return err
+		}
+
+		if err := fn(todos); err != nil {
+			return err
+		}
+		if len(todos) < batchSize {
+			return nil
+		}
+		lastID = todos[len(todos)-1].ID
+	}
+}
+
+// FindByIDs finds the todos visible to the user with the given IDs
+func (r *todoRepository) FindByIDs(ctx context.Context, userID uint, ids []uint) ([]entity.Todo, error) {
+	var todos []entity.Todo
+	if len(ids) == 0 {
+		return todos, nil
+	}
+
+	result := database.Conn(ctx, r.db).
+		Scopes(visibleTo(userID)).
+		Where("id IN ?", ids).
+		Order("id").
+		Find(&todos)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	if err := r.hydrate(ctx, todos); err != nil {
+		return nil, err
+	}
+	return todos, nil
+}
+
+// FindByTitles finds the user's todos having any of the given titles
+func (r *todoRepository) FindByTitles(ctx context.Context, userID uint, titles []string) ([]entity.Todo, error) {
+	var todos []entity.Todo
+	if len(titles) == 0 {
+		return todos, nil
+	}
+
+	result := database.Conn(ctx, r.db).
+		Scopes(ownedBy(userID)).
+		Where("title IN ?", titles).
+		Order("id").
+		Find(&todos)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return todos, nil
+}
+
+// CreateBatch creates several todos with a single INSERT, placing those
+// without a position after all other todos in slice order
+func (r *todoRepository) CreateBatch(ctx context.Context, todos []entity.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+	if err := r.appendPositions(ctx, todos); err != nil {
+		return err
+	}
+
+	result := database.Conn(ctx, r.db).Create(&todos)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// UpdateBatch updates several todos with one versioned UPDATE each
+func (r *todoRepository) UpdateBatch(ctx context.Context, todos []entity.Todo) error {
+	for i := range todos {
+		if err := r.Update(ctx, &todos[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteBatch moves several todos to the trash with a single UPDATE
+func (r *todoRepository) DeleteBatch(ctx context.Context, userID uint, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	result := database.Conn(ctx, r.db).Scopes(visibleTo(userID)).Delete(&entity.Todo{}, ids)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected != int64(len(ids)) {
+		return domain.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// checklistRepository implements contract.ChecklistRepository
+type checklistRepository struct {
+	db *gorm.DB
+}
+
+// NewChecklistRepository creates a new ChecklistRepository instance
+func NewChecklistRepository(db *gorm.DB) contract.ChecklistRepository {
+	return &checklistRepository{db: db}
+}
+
+// Create creates a new checklist item
+func (r *checklistRepository) Create(ctx context.Context, item *entity.ChecklistItem) error {
+	result := database.Conn(ctx, r.db).Create(item)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByID finds a checklist item of a todo by its ID
+func (r *checklistRepository) FindByID(ctx context.Context, todoID, id uint) (*entity.ChecklistItem, error) {
+	var item entity.ChecklistItem
+	result := database.Conn(ctx, r.db).Where("todo_id = ?", todoID).First(&item, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &item, nil
+}
+
+// FindByTodo retrieves all checklist items of a todo ordered by position
+func (r *checklistRepository) FindByTodo(ctx context.Context, todoID uint) ([]entity.ChecklistItem, error) {
+	var items []entity.ChecklistItem
+	result := database.Conn(ctx, r.db).Where("todo_id = ?", todoID).Order("position ASC, id ASC").Find(&items)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return items, nil
+}
+
+// Update updates an existing checklist item
+func (r *checklistRepository) Update(ctx context.Context, item *entity.ChecklistItem) error {
+	result := database.Conn(ctx, r.db).
+		Model(item).
+		Where("todo_id = ?", item.TodoID).
+		Select("*").
+		Omit("id", "todo_id", "created_at").
+		Updates(item)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes a checklist item of a todo by its ID
+func (r *checklistRepository) Delete(ctx context.Context, todoID, id uint) error {
+	result := database.Conn(ctx, r.db).Where("todo_id = ?", todoID).Delete(&entity.ChecklistItem{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Reorder assigns positions following the order of ids in a single statement
+func (r *checklistRepository) Reorder(ctx context.Context, todoID uint, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	result := database.Conn(ctx, r.db).Exec(
+		"UPDATE checklist_items SET position = ordered.position - 1, updated_at = NOW() "+
+			"FROM unnest(?::int[]) WITH ORDINALITY AS ordered(id, position) "+
+			"WHERE checklist_items.id = ordered.id AND checklist_items.todo_id = ?",
+		intArray(ids), todoID,
+	)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected != int64(len(ids)) {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// progressRow is the checklist progress of a single todo
+type progressRow struct {
+	TodoID uint
+	Done   int
+	Total  int
+}
+
+// loadProgress populates the checklist Progress of every given todo
+func (r *todoRepository) loadProgress(ctx context.Context, todos []entity.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(todos))
+	for _, t := range todos {
+		ids = append(ids, t.ID)
+	}
+
+	var rows []progressRow
+	result := database.Conn(ctx, r.db).
+		Model(&entity.ChecklistItem{}).
+		Select("todo_id, COUNT(*) FILTER (WHERE completed) AS done, COUNT(*) AS total").
+		Where("todo_id IN ?", ids).
+		Group("todo_id").
+		Find(&rows)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+
+	byTodo := make(map[uint]entity.TodoProgress, len(rows))
+	for _, row := range rows {
+		byTodo[row.TodoID] = entity.TodoProgress{Done: row.Done, Total: row.Total}
+	}
+	for i := range todos {
+		todos[i].Progress = byTodo[todos[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// openBlockers selects the links to blockers that are neither completed
+// nor in the trash
+const openBlockers = "FROM todo_dependencies " +
+	"JOIN todos AS blockers ON blockers.id = todo_dependencies.blocker_id " +
+	"WHERE blockers.completed = FALSE AND blockers.deleted_at IS NULL"
+
+// dependencyGraph walks the links upstream to the blockers of a todo and
+// downstream to the todos it blocks. UNION drops links already visited, so
+// the walk ends even if the links were to form a cycle.
+const dependencyGraph = `
+WITH RECURSIVE upstream AS (
+	SELECT todo_id, blocker_id FROM todo_dependencies WHERE todo_id = @id
+	UNION
+	SELECT d.todo_id, d.blocker_id FROM todo_dependencies d JOIN upstream u ON d.todo_id = u.blocker_id
+), downstream AS (
+	SELECT todo_id, blocker_id FROM todo_dependencies WHERE blocker_id = @id
+	UNION
+	SELECT d.todo_id, d.blocker_id FROM todo_dependencies d JOIN downstream w ON d.blocker_id = w.todo_id
+)
+SELECT todo_id, blocker_id FROM upstream
+UNION
+SELECT todo_id, blocker_id FROM downstream
+ORDER BY todo_id, blocker_id
+LIMIT @limit`
+
+// blockerChain tells whether a todo reaches a blocker by following links
+// upstream
+const blockerChain = `
+WITH RECURSIVE chain AS (
+	SELECT blocker_id FROM todo_dependencies WHERE todo_id = ?
+	UNION
+	SELECT d.blocker_id FROM todo_dependencies d JOIN chain c ON d.todo_id = c.blocker_id
+)
+SELECT EXISTS (SELECT 1 FROM chain WHERE blocker_id = ?)`
+
+// ready scopes a query to open todos without open blockers
+func ready(db *gorm.DB) *gorm.DB {
+	return db.Where("completed = FALSE AND NOT EXISTS (SELECT 1 " + openBlockers + " AND todo_dependencies.todo_id = todos.id)")
+}
+
+// dependencyRepository implements contract.TodoDependencyRepository
+type dependencyRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoDependencyRepository creates a new TodoDependencyRepository instance
+func NewTodoDependencyRepository(db *gorm.DB) contract.TodoDependencyRepository {
+	return &dependencyRepository{db: db}
+}
+
+// LockGraph locks the links against writes by other transactions while
+// still allowing them to be read
+func (r *dependencyRepository) LockGraph(ctx context.Context) error {
+	result := database.Conn(ctx, r.db).Exec("LOCK TABLE todo_dependencies IN SHARE ROW EXCLUSIVE MODE")
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// DependsOn reports whether a todo is blocked by another, directly or
+// through a chain of blockers
+func (r *dependencyRepository) DependsOn(ctx context.Context, todoID, blockerID uint) (bool, error) {
+	var exists bool
+	result := database.Conn(ctx, r.db).Raw(blockerChain, todoID, blockerID).Scan(&exists)
+	if result.Error != nil {
+		return false, domain.ErrDatabaseOperation
+	}
+	return exists, nil
+}
+
+// Create links a todo to its blocker, ignoring an existing link
+func (r *dependencyRepository) Create(ctx context.Context, todoID, blockerID uint) error {
+	result := database.Conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entity.TodoDependency{TodoID: todoID, BlockerID: blockerID})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// Delete unlinks a todo from its blocker
+func (r *dependencyRepository) Delete(ctx context.Context, todoID, blockerID uint) error {
+	result := database.Conn(ctx, r.db).
+		Where("todo_id = ? AND blocker_id = ?", todoID, blockerID).
+		Delete(&entity.TodoDependency{})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// FindGraph retrieves the links among the todos a todo is transitively
+// blocked by or transitively blocks
+func (r *dependencyRepository) FindGraph(ctx context.Context, todoID uint, limit int) ([]entity.TodoDependency, error) {
+	var links []entity.TodoDependency
+	result := database.Conn(ctx, r.db).
+		Raw(dependencyGraph, map[string]interface{}{"id": todoID, "limit": limit}).
+		Scan(&links)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return links, nil
+}
+
+// FindBlocked returns those of the given todos that have an open blocker
+func (r *dependencyRepository) FindBlocked(ctx context.Context, todoIDs []uint) ([]uint, error) {
+	if len(todoIDs) == 0 {
+		return nil, nil
+	}
+
+	var ids []uint
+	result := database.Conn(ctx, r.db).
+		Raw("SELECT DISTINCT todo_dependencies.todo_id "+openBlockers+" AND todo_dependencies.todo_id IN ?", todoIDs).
+		Scan(&ids)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return ids, nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// todoEventRepository implements contract.TodoEventRepository
+type todoEventRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoEventRepository creates a new TodoEventRepository instance
+func NewTodoEventRepository(db *gorm.DB) contract.TodoEventRepository {
+	return &todoEventRepository{db: db}
+}
+
+// Create appends an event
+func (r *todoEventRepository) Create(ctx context.Context, event *entity.TodoEvent) error {
+	result := database.Conn(ctx, r.db).Create(event)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// CreateBatch appends several events with a single INSERT
+func (r *todoEventRepository) CreateBatch(ctx context.Context, events []entity.TodoEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	result := database.Conn(ctx, r.db).Create(&events)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// FindByTodo retrieves one page of a todo's events, newest first
+func (r *todoEventRepository) FindByTodo(ctx context.Context, todoID uint, limit, offset int) ([]entity.TodoEvent, int64, error) {
+	var total int64
+	result := database.Conn(ctx, r.db).
+		Model(&entity.TodoEvent{}).
+		Where("todo_id = ?", todoID).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	var events []entity.TodoEvent
+	result = database.Conn(ctx, r.db).
+		Where("todo_id = ?", todoID).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&events)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+	return events, total, nil
+}
+
+// FindAfter retrieves the events of a todo newer than the given version
+func (r *todoEventRepository) FindAfter(ctx context.Context, todoID, version uint) ([]entity.TodoEvent, error) {
+	var events []entity.TodoEvent
+	result := database.Conn(ctx, r.db).
+		Where("todo_id = ? AND version > ?", todoID, version).
+		Order("id DESC").
+		Find(&events)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return events, nil
+}
+
+// FindFirst finds the oldest event of a todo
+func (r *todoEventRepository) FindFirst(ctx context.Context, todoID uint) (*entity.TodoEvent, error) {
+	var event entity.TodoEvent
+	result := database.Conn(ctx, r.db).Where("todo_id = ?", todoID).Order("id ASC").First(&event)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &event, nil
+}
@@ -0,0 +1,334 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"github.com/arulkarim/golden-architecture/pkg/rank"
+	"gorm.io/gorm"
+)
+
+// rebalanceBatchSize is the number of todos rewritten per rebalance UPDATE
+const rebalanceBatchSize = 1000
+
+// positionOrder sorts positions byte-wise, the order ranks are built for
+const positionOrder = `position COLLATE "C"`
+
+// Advisory lock namespaces of the two kinds of lists, hashed into the first
+// key of pg_advisory_xact_lock
+const (
+	inboxLockSpace   = "todos.inbox"
+	projectLockSpace = "todos.project"
+)
+
+// listKey is a comparable form of contract.TodoList
+type listKey struct {
+	projectID uint
+	userID    uint
+}
+
+// keyOf returns the key of the list a todo is ordered in
+func keyOf(todo *entity.Todo) listKey {
+	if todo.ProjectID != nil {
+		return listKey{projectID: *todo.ProjectID}
+	}
+	return listKey{userID: todo.UserID}
+}
+
+// list returns the list the key identifies
+func (k listKey) list() contract.TodoList {
+	if k.projectID != 0 {
+		projectID := k.projectID
+		return contract.TodoList{ProjectID: &projectID}
+	}
+	return contract.TodoList{UserID: k.userID}
+}
+
+// inList scopes a query to the todos of a list. Positions are unique among
+// trashed todos too, so callers query it Unscoped.
+func inList(list contract.TodoList) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if list.ProjectID != nil {
+			return db.Where("project_id = ?", *list.ProjectID)
+		}
+		return db.Where("project_id IS NULL AND user_id = ?", list.UserID)
+	}
+}
+
+// LockList takes a transaction-level advisory lock on a list
+func (r *todoRepository) LockList(ctx context.Context, list contract.TodoList) error {
+	space, id := inboxLockSpace, list.UserID
+	if list.ProjectID != nil {
+		space, id = projectLockSpace, *list.ProjectID
+	}
+
+	result := database.Conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(hashtext(?), ?::int)", space, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// appendPositions gives the todos without a position ranks after the last
+// todo of their lists, in slice order. The lists are locked in key order so
+// that concurrent batches cannot deadlock.
+func (r *todoRepository) appendPositions(ctx context.Context, todos []entity.Todo) error {
+	var keys []listKey
+	for i := range todos {
+		if todos[i].Position == "" {
+			keys = append(keys, keyOf(&todos[i]))
+		}
+	}
+	slices.SortFunc(keys, func(a, b listKey) int {
+		return cmp.Or(cmp.Compare(a.projectID, b.projectID), cmp.Compare(a.userID, b.userID))
+	})
+	keys = slices.Compact(keys)
+
+	last := make(map[listKey]string, len(keys))
+	for _, key := range keys {
+		if err := r.LockList(ctx, key.list()); err != nil {
+			return err
+		}
+		position, err := r.lastPosition(ctx, key.list())
+		if err != nil {
+			return err
+		}
+		last[key] = position
+	}
+
+	for i := range todos {
+		if todos[i].Position != "" {
+			continue
+		}
+		key := keyOf(&todos[i])
+		position, err := rank.Between(last[key], "")
+		if err != nil {
+			return domain.ErrDatabaseOperation
+		}
+		todos[i].Position = position
+		last[key] = position
+	}
+	return nil
+}
+
+// lastPosition returns the greatest position in a list, including trashed
+// todos, or an empty string when the list holds no ranked todos
+func (r *todoRepository) lastPosition(ctx context.Context, list contract.TodoList) (string, error) {
+	var positions []string
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Model(&entity.Todo{}).
+		Scopes(inList(list)).
+		Where("position <> ''").
+		Order(positionOrder+" DESC").
+		Limit(1).
+		Pluck("position", &positions)
+	if result.Error != nil {
+		return "", domain.ErrDatabaseOperation
+	}
+	if len(positions) == 0 {
+		return "", nil
+	}
+	return positions[0], nil
+}
+
+// AppendPosition places a todo after the todos of the list it belongs to
+func (r *todoRepository) AppendPosition(ctx context.Context, todo *entity.Todo) error {
+	list := contract.TodoListOf(todo)
+	if err := r.LockList(ctx, list); err != nil {
+		return err
+	}
+	last, err := r.lastPosition(ctx, list)
+	if err != nil {
+		return err
+	}
+
+	position, err := rank.Between(last, "")
+	if err != nil {
+		return domain.ErrDatabaseOperation
+	}
+	todo.Position = position
+	return nil
+}
+
+// FindPosition returns the ID, owner, project and position of a todo
+// visible to the user
+func (r *todoRepository) FindPosition(ctx context.Context, userID, id uint) (*entity.Todo, error) {
+	var todo entity.Todo
+	result := database.Conn(ctx, r.db).
+		Scopes(visibleTo(userID)).
+		Select("id", "user_id", "project_id", "position").
+		First(&todo, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabaseOperation
+	}
+	return &todo, nil
+}
+
+// AdjacentPosition returns the position next to the given one among the
+// todos of a list except excludeID, including trashed ones
+func (r *todoRepository) AdjacentPosition(ctx context.Context, list contract.TodoList, position string, excludeID uint, next bool) (string, error) {
+	db := database.Conn(ctx, r.db).
+		Unscoped().
+		Model(&entity.Todo{}).
+		Scopes(inList(list)).
+		Where("id <> ? AND position <> ''", excludeID)
+	if next {
+		db = db.Where(positionOrder+" > ?", position).Order(positionOrder)
+	} else {
+		db = db.Where(positionOrder+" < ?", position).Order(positionOrder + " DESC")
+	}
+
+	var positions []string
+	if result := db.Limit(1).Pluck("position", &positions); result.Error != nil {
+		return "", domain.ErrDatabaseOperation
+	}
+	if len(positions) == 0 {
+		return "", nil
+	}
+	return positions[0], nil
+}
+
+// Reposition writes the position of a todo and advances its version,
+// leaving every other column untouched
+func (r *todoRepository) Reposition(ctx context.Context, todo *entity.Todo) error {
+	result := database.Conn(ctx, r.db).
+		Model(&entity.Todo{}).
+		Scopes(ownedBy(todo.UserID)).
+		Where("id = ? AND version = ?", todo.ID, todo.Version).
+		Updates(map[string]interface{}{
+			"position": todo.Position,
+			"version":  gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return r.missingOrStale(ctx, todo.UserID, todo.ID)
+	}
+	todo.Version++
+	return nil
+}
+
+// MoveToInbox appends the todos of a project to their owners' inboxes.
+// The todos are loaded without their project, so their positions are
+// appended to the inboxes, which are locked in owner order.
+func (r *todoRepository) MoveToInbox(ctx context.Context, projectID uint) error {
+	db := database.Conn(ctx, r.db)
+	var todos []entity.Todo
+	result := db.Unscoped().
+		Scopes(inList(contract.TodoList{ProjectID: &projectID})).
+		Select("id", "user_id").
+		Order("user_id, " + positionOrder + ", id").
+		Find(&todos)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if err := r.appendPositions(ctx, todos); err != nil {
+		return err
+	}
+
+	result = db.Unscoped().
+		Model(&entity.Todo{}).
+		Scopes(inList(contract.TodoList{ProjectID: &projectID})).
+		UpdateColumns(map[string]interface{}{"project_id": nil, "position": ""})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+
+	ids := make([]uint, 0, len(todos))
+	positions := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		ids = append(ids, todo.ID)
+		positions = append(positions, todo.Position)
+	}
+	return r.writePositions(ctx, ids, positions)
+}
+
+// ListsOverLength returns the lists holding a position longer than
+// maxLength
+func (r *todoRepository) ListsOverLength(ctx context.Context, maxLength int) ([]contract.TodoList, error) {
+	var rows []struct {
+		ProjectID *uint
+		UserID    uint
+	}
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Model(&entity.Todo{}).
+		Select("project_id, CASE WHEN project_id IS NULL THEN user_id ELSE 0 END AS user_id").
+		Group("1, 2").
+		Having("MAX(length(position)) > ?", maxLength).
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+
+	lists := make([]contract.TodoList, 0, len(rows))
+	for _, row := range rows {
+		lists = append(lists, contract.TodoList{UserID: row.UserID, ProjectID: row.ProjectID})
+	}
+	return lists, nil
+}
+
+// Rebalance locks a list, then rewrites its positions in their current
+// order one batch per UPDATE. The positions are cleared first, since the
+// unique index is checked row by row and ignores empty positions.
+func (r *todoRepository) Rebalance(ctx context.Context, list contract.TodoList) (int, error) {
+	if err := r.LockList(ctx, list); err != nil {
+		return 0, err
+	}
+
+	db := database.Conn(ctx, r.db)
+	var ids []uint
+	result := db.Unscoped().
+		Model(&entity.Todo{}).
+		Scopes(inList(list)).
+		Order(positionOrder+", id").
+		Pluck("id", &ids)
+	if result.Error != nil {
+		return 0, domain.ErrDatabaseOperation
+	}
+
+	result = db.Unscoped().
+		Model(&entity.Todo{}).
+		Scopes(inList(list)).
+		UpdateColumn("position", "")
+	if result.Error != nil {
+		return 0, domain.ErrDatabaseOperation
+	}
+
+	if err := r.writePositions(ctx, ids, rank.Spread(len(ids))); err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// writePositions sets the positions of todos by ID, one batch per UPDATE,
+// and advances their versions
+func (r *todoRepository) writePositions(ctx context.Context, ids []uint, positions []string) error {
+	db := database.Conn(ctx, r.db)
+	for start := 0; start < len(ids); start += rebalanceBatchSize {
+		end := min(start+rebalanceBatchSize, len(ids))
+		// Ranks only hold the digits 0-9a-z, so they need no quoting
+		result := db.Exec(
+			"UPDATE todos SET position = ranked.position, version = todos.version + 1 "+
+				"FROM unnest(?::int[], ?::text[]) AS ranked(id, position) "+
+				"WHERE todos.id = ranked.id",
+			intArray(ids[start:end]), "{"+strings.Join(positions[start:end], ",")+"}",
+		)
+		if result.Error != nil {
+			return domain.ErrDatabaseOperation
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// todoSortColumns whitelists the expressions a todo listing may be sorted by
+var todoSortColumns = map[string]string{
+	contract.TodoSortCreatedAt: "created_at",
+	contract.TodoSortUpdatedAt: "updated_at",
+	contract.TodoSortTitle:     "title",
+	contract.TodoSortDueAt:     "due_at",
+	contract.TodoSortPosition:  positionOrder,
+	contract.TodoSortPriority:  "CASE priority WHEN 'low' THEN 0 WHEN 'medium' THEN 1 WHEN 'high' THEN 2 WHEN 'urgent' THEN 3 END",
+}
+
+// filtered scopes a query to the todos matching the filter
+func filtered(f contract.TodoFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Scopes(visibleTo(f.UserID))
+		if f.ProjectID != nil {
+			db = db.Where("project_id = ?", *f.ProjectID)
+		}
+		if f.Status != "" {
+			db = db.Where("status = ?", f.Status)
+		}
+		if f.Completed != nil {
+			db = db.Where("completed = ?", *f.Completed)
+		}
+		if f.Priority != "" {
+			db = db.Where("priority = ?", f.Priority)
+		}
+		if len(f.Tags) > 0 {
+			db = db.Scopes(taggedWith(f.Tags, f.TagMatch == contract.TagMatchAll))
+		}
+		if f.CreatedFrom != nil {
+			db = db.Where("created_at >= ?", *f.CreatedFrom)
+		}
+		if f.CreatedTo != nil {
+			db = db.Where("created_at <= ?", *f.CreatedTo)
+		}
+		if f.UpdatedFrom != nil {
+			db = db.Where("updated_at >= ?", *f.UpdatedFrom)
+		}
+		if f.UpdatedTo != nil {
+			db = db.Where("updated_at <= ?", *f.UpdatedTo)
+		}
+		if f.DueFrom != nil {
+			db = db.Where("due_at >= ?", *f.DueFrom)
+		}
+		if f.DueBefore != nil {
+			db = db.Where("due_at < ?", *f.DueBefore)
+		}
+		if f.Ready {
+			db = db.Scopes(ready)
+		}
+		return db
+	}
+}
+
+// todoOrder builds the ORDER BY clause for a todo listing.
+// Todos without a value sort last, and the primary key is used as a
+// tie-breaker so pages are stable.
+func todoOrder(sortBy, sortOrder string) (clause.OrderBy, error) {
+	column, ok := todoSortColumns[sortBy]
+	if !ok {
+		return clause.OrderBy{}, domain.ErrInvalidInput
+	}
+
+	var direction string
+	switch sortOrder {
+	case contract.SortAsc:
+		direction = "ASC"
+	case contract.SortDesc:
+		direction = "DESC"
+	default:
+		return clause.OrderBy{}, domain.ErrInvalidInput
+	}
+
+	return clause.OrderBy{Expression: clause.Expr{
+		SQL:                fmt.Sprintf("%s %s NULLS LAST, id %s", column, direction, direction),
+		WithoutParentheses: true,
+	}}, nil
+}
+
+// intArray formats ids as a PostgreSQL array literal. GORM expands slice
+// arguments into value lists, so array parameters are passed as text.
+func intArray(ids []uint) string {
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, strconv.FormatUint(uint64(id), 10))
+	}
+	return "{" + strings.Join(items, ",") + "}"
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"gorm.io/gorm/clause"
+)
+
+func TestTodoOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "newest first",
+			sortBy:    contract.TodoSortCreatedAt,
+			sortOrder: contract.SortDesc,
+			want:      "created_at DESC NULLS LAST, id DESC",
+		},
+		{
+			name:      "due date ascending",
+			sortBy:    contract.TodoSortDueAt,
+			sortOrder: contract.SortAsc,
+			want:      "due_at ASC NULLS LAST, id ASC",
+		},
+		{
+			name:      "priority by rank",
+			sortBy:    contract.TodoSortPriority,
+			sortOrder: contract.SortDesc,
+			want:      todoSortColumns[contract.TodoSortPriority] + " DESC NULLS LAST, id DESC",
+		},
+		{
+			name:      "unknown column",
+			sortBy:    "title; DROP TABLE todos",
+			sortOrder: contract.SortAsc,
+			wantErr:   true,
+		},
+		{
+			name:      "unknown direction",
+			sortBy:    contract.TodoSortTitle,
+			sortOrder: "sideways",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := todoOrder(tt.sortBy, tt.sortOrder)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidInput) {
+					t.Fatalf("todoOrder() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("todoOrder() unexpected error: %v", err)
+			}
+			if sql := got.Expression.(clause.Expr).SQL; sql != tt.want {
+				t.Errorf("todoOrder() = %q, want %q", sql, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntArray(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []uint
+		want string
+	}{
+		{name: "empty", want: "{}"},
+		{name: "one", ids: []uint{7}, want: "{7}"},
+		{name: "several", ids: []uint{1, 20, 300}, want: "{1,20,300}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := intArray(tt.ids); got != tt.want {
+				t.Errorf("intArray(%v) = %q, want %q", tt.ids, got, tt.want)
+			}
+		})
+	}
+}
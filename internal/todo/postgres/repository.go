@@ -3,10 +3,12 @@ package postgres
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/arulkarim/golden-architecture/internal/domain"
 	"github.com/arulkarim/golden-architecture/internal/domain/contract"
 	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
 	"gorm.io/gorm"
 )
 
@@ -20,9 +22,46 @@ func NewTodoRepository(db *gorm.DB) contract.TodoRepository {
 	return &todoRepository{db: db}
 }
 
-// Create creates a new todo item
+// ownedBy scopes a query to todos belonging to the given user
+func ownedBy(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID)
+	}
+}
+
+// visibleTo scopes a query to the todos the given user can see: their own
+// and those in projects they are a member of
+func visibleTo(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(
+			"todos.user_id = ? OR todos.project_id IN (SELECT project_members.project_id FROM project_members WHERE project_members.user_id = ?)",
+			userID, userID,
+		)
+	}
+}
+
+// hydrate populates the derived fields of the given todos, using one
+// query per field for the whole slice
+func (r *todoRepository) hydrate(ctx context.Context, todos []entity.Todo) error {
+	if err := r.loadTags(ctx, todos); err != nil {
+		return err
+	}
+	if err := r.loadProgress(ctx, todos); err != nil {
+		return err
+	}
+	return r.loadTrackedTime(ctx, todos)
+}
+
+// Create creates a new todo item, placed after all other todos unless it
+// already has a position
 func (r *todoRepository) Create(ctx context.Context, todo *entity.Todo) error {
-	result := r.db.WithContext(ctx).Create(todo)
+	todos := []entity.Todo{*todo}
+	if err := r.appendPositions(ctx, todos); err != nil {
+		return err
+	}
+	todo.Position = todos[0].Position
+
+	result := database.Conn(ctx, r.db).Create(todo)
 	if result.Error != nil {
 		return domain.ErrDatabaseOperation
 	}
@@ -30,40 +69,118 @@ func (r *todoRepository) Create(ctx context.Context, todo *entity.Todo) error {
 }
 
 // FindByID finds a todo by its ID
-func (r *todoRepository) FindByID(ctx context.Context, id uint) (*entity.Todo, error) {
+func (r *todoRepository) FindByID(ctx context.Context, userID, id uint) (*entity.Todo, error) {
 	var todo entity.Todo
-	result := r.db.WithContext(ctx).First(&todo, id)
+	result := database.Conn(ctx, r.db).Scopes(visibleTo(userID)).First(&todo, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrNotFound
 		}
 		return nil, domain.ErrDatabaseOperation
 	}
-	return &todo, nil
+
+	todos := []entity.Todo{todo}
+	if err := r.hydrate(ctx, todos); err != nil {
+		return nil, err
+	}
+	return &todos[0], nil
 }
 
-// FindAll retrieves all todos
-func (r *todoRepository) FindAll(ctx context.Context) ([]entity.Todo, error) {
+// FindAll retrieves one page of todos matching the query
+func (r *todoRepository) FindAll(ctx context.Context, query contract.TodoQuery) ([]entity.Todo, int64, error) {
+	order, err := todoOrder(query.SortBy, query.SortOrder)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	result := database.Conn(ctx, r.db).Model(&entity.Todo{}).Scopes(filtered(query.TodoFilter)).Count(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
 	var todos []entity.Todo
-	result := r.db.WithContext(ctx).Order("created_at DESC").Find(&todos)
+	result = database.Conn(ctx, r.db).
+		Scopes(filtered(query.TodoFilter)).
+		Order(order).
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&todos)
 	if result.Error != nil {
-		return nil, domain.ErrDatabaseOperation
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+	if err := r.hydrate(ctx, todos); err != nil {
+		return nil, 0, err
 	}
-	return todos, nil
+	return todos, total, nil
 }
 
-// Update updates an existing todo
+// FindByCursor retrieves one keyset page of todos ordered newest first
+func (r *todoRepository) FindByCursor(ctx context.Context, query contract.TodoCursorQuery) ([]entity.Todo, bool, error) {
+	db := database.Conn(ctx, r.db).Scopes(filtered(query.TodoFilter))
+	if query.Before != nil {
+		// Walk backwards in ascending order, then restore the newest-first order
+		db = db.Where("(created_at, id) > (?, ?)", query.Before.CreatedAt, query.Before.ID).
+			Order("created_at ASC, id ASC")
+	} else {
+		if query.After != nil {
+			db = db.Where("(created_at, id) < (?, ?)", query.After.CreatedAt, query.After.ID)
+		}
+		db = db.Order("created_at DESC, id DESC")
+	}
+
+	// Fetch one extra row to find out whether another page exists
+	var todos []entity.Todo
+	result := db.Limit(query.Limit + 1).Find(&todos)
+	if result.Error != nil {
+		return nil, false, domain.ErrDatabaseOperation
+	}
+
+	hasMore := len(todos) > query.Limit
+	if hasMore {
+		todos = todos[:query.Limit]
+	}
+	if query.Before != nil {
+		slices.Reverse(todos)
+	}
+	if err := r.hydrate(ctx, todos); err != nil {
+		return nil, false, err
+	}
+	return todos, hasMore, nil
+}
+
+// Update updates an existing todo if it is still at todo.Version and
+// advances the version.
+// Save is avoided on purpose: when no row matches it falls back to an
+// upsert, which would let a caller overwrite another user's todo.
 func (r *todoRepository) Update(ctx context.Context, todo *entity.Todo) error {
-	result := r.db.WithContext(ctx).Save(todo)
+	version := todo.Version
+	todo.Version++
+
+	result := database.Conn(ctx, r.db).
+		Model(todo).
+		Scopes(ownedBy(todo.UserID)).
+		Where("version = ?", version).
+		Select("*").
+		Omit("id", "user_id", "created_at", "deleted_at").
+		Updates(todo)
 	if result.Error != nil {
+		todo.Version = version
 		return domain.ErrDatabaseOperation
 	}
+	if result.RowsAffected == 0 {
+		todo.Version = version
+		return r.missingOrStale(ctx, todo.UserID, todo.ID)
+	}
 	return nil
 }
 
-// Delete deletes a todo by its ID
-func (r *todoRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).Delete(&entity.Todo{}, id)
+// Touch advances the version of a todo whose tags or checklist changed
+func (r *todoRepository) Touch(ctx context.Context, id uint) error {
+	result := database.Conn(ctx, r.db).
+		Model(&entity.Todo{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"version": gorm.Expr("version + 1")})
 	if result.Error != nil {
 		return domain.ErrDatabaseOperation
 	}
@@ -72,3 +189,38 @@ func (r *todoRepository) Delete(ctx context.Context, id uint) error {
 	}
 	return nil
 }
+
+// Delete moves a todo to the trash by its ID. A non-zero version makes
+// the delete conditional on the todo still being at that version.
+func (r *todoRepository) Delete(ctx context.Context, userID, id, version uint) error {
+	db := database.Conn(ctx, r.db).Scopes(visibleTo(userID))
+	if version > 0 {
+		db = db.Where("version = ?", version)
+	}
+
+	result := db.Delete(&entity.Todo{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return r.missingOrStale(ctx, userID, id)
+	}
+	return nil
+}
+
+// missingOrStale explains why a versioned write to a todo matched no rows
+func (r *todoRepository) missingOrStale(ctx context.Context, userID, id uint) error {
+	var count int64
+	result := database.Conn(ctx, r.db).
+		Model(&entity.Todo{}).
+		Scopes(visibleTo(userID)).
+		Where("id = ?", id).
+		Count(&count)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if count == 0 {
+		return domain.ErrNotFound
+	}
+	return domain.ErrVersionConflict
+}
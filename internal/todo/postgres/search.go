@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+)
+
+// searchConfig is the text search configuration used by the search_vector column
+const searchConfig = "simple"
+
+// ts_headline marks matches with control characters stripped from the
+// text beforehand, so the text can be HTML-escaped before the markers are
+// turned into <mark> tags
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+// ts_headline options: titles are short and highlighted whole, descriptions
+// are cut down to the fragments around the matches
+const (
+	titleHeadlineOptions       = "HighlightAll=true, StartSel=" + highlightStart + ", StopSel=" + highlightStop
+	descriptionHeadlineOptions = "StartSel=" + highlightStart + ", StopSel=" + highlightStop + ", MaxFragments=2, MinWords=5, MaxWords=20"
+)
+
+// highlightMarkup turns highlight markers into <mark> tags
+var highlightMarkup = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// searchRow is a todo row extended with its search rank and highlights
+type searchRow struct {
+	entity.Todo
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// Search retrieves one page of todos matching a full-text query
+func (r *todoRepository) Search(ctx context.Context, query contract.TodoSearchQuery) ([]contract.TodoSearchResult, int64, error) {
+	tsquery, args := buildTSQuery(query.Query)
+	if tsquery == "" {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	// The parsed query is computed once and joined to every candidate row.
+	// GORM cannot place its soft-delete condition on this FROM list, so the
+	// queries are unscoped and exclude trashed todos themselves.
+	from := "todos, (SELECT " + tsquery + " AS q) AS search"
+
+	var total int64
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Table(from, args...).
+		Scopes(visibleTo(query.UserID)).
+		Where("todos.deleted_at IS NULL AND todos.search_vector @@ search.q").
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	var rows []searchRow
+	result = database.Conn(ctx, r.db).
+		Unscoped().
+		Table(from, args...).
+		Select(
+			"todos.*, ts_rank(todos.search_vector, search.q) AS rank, "+
+				"ts_headline(?, translate(todos.title, ?, ''), search.q, ?) AS title_highlight, "+
+				"ts_headline(?, translate(coalesce(todos.description, ''), ?, ''), search.q, ?) AS description_highlight",
+			searchConfig, highlightStart+highlightStop, titleHeadlineOptions,
+			searchConfig, highlightStart+highlightStop, descriptionHeadlineOptions,
+		).
+		Scopes(visibleTo(query.UserID)).
+		Where("todos.deleted_at IS NULL AND todos.search_vector @@ search.q").
+		Order("rank DESC, todos.id DESC").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&rows)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	todos := make([]entity.Todo, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, row.Todo)
+	}
+	if err := r.hydrate(ctx, todos); err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]contract.TodoSearchResult, 0, len(rows))
+	for i, row := range rows {
+		results = append(results, contract.TodoSearchResult{
+			Todo:                 todos[i],
+			Rank:                 row.Rank,
+			TitleHighlight:       markHighlights(row.TitleHighlight),
+			DescriptionHighlight: markHighlights(row.DescriptionHighlight),
+		})
+	}
+	return results, total, nil
+}
+
+// markHighlights HTML-escapes a ts_headline snippet and wraps its matches
+// in <mark></mark>, so the snippet is safe to render as HTML
+func markHighlights(snippet string) string {
+	return highlightMarkup.Replace(html.EscapeString(snippet))
+}
+
+// buildTSQuery translates a user search string into a tsquery SQL expression
+// and its bind arguments. Double-quoted text becomes a phrase query, words
+// ending in * become prefix queries and all other words must match; every
+// term is combined with AND. An empty expression means nothing to search for.
+func buildTSQuery(input string) (string, []interface{}) {
+	var parts []string
+	var args []interface{}
+
+	for _, term := range splitSearchTerms(input) {
+		switch {
+		case term.phrase:
+			parts = append(parts, "phraseto_tsquery('"+searchConfig+"', ?)")
+			args = append(args, term.text)
+		case strings.HasSuffix(term.text, "*"):
+			word := strings.TrimRight(term.text, "*")
+			if word == "" {
+				continue
+			}
+			parts = append(parts, "to_tsquery('"+searchConfig+"', ?)")
+			args = append(args, quoteLexeme(word)+":*")
+		default:
+			parts = append(parts, "plainto_tsquery('"+searchConfig+"', ?)")
+			args = append(args, term.text)
+		}
+	}
+
+	if len(parts) == 0 {
+		return "", nil
+	}
+	return "(" + strings.Join(parts, " && ") + ")", args
+}
+
+// searchTerm is a single word or quoted phrase of a search string
+type searchTerm struct {
+	text   string
+	phrase bool
+}
+
+// splitSearchTerms splits a search string on whitespace, keeping
+// double-quoted phrases together. An unterminated quote runs to the end.
+func splitSearchTerms(input string) []searchTerm {
+	var terms []searchTerm
+	var current strings.Builder
+	inPhrase := false
+
+	flush := func(phrase bool) {
+		text := strings.TrimSpace(current.String())
+		if text != "" {
+			terms = append(terms, searchTerm{text: text, phrase: phrase})
+		}
+		current.Reset()
+	}
+
+	for _, r := range input {
+		switch {
+		case r == '"':
+			flush(inPhrase)
+			inPhrase = !inPhrase
+		case unicode.IsSpace(r) && !inPhrase:
+			flush(false)
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush(inPhrase)
+
+	return terms
+}
+
+// quoteLexeme quotes a word as a tsquery lexeme so operators inside it are
+// treated as text
+func quoteLexeme(word string) string {
+	word = strings.ReplaceAll(word, `\`, `\\`)
+	return "'" + strings.ReplaceAll(word, "'", "''") + "'"
+}
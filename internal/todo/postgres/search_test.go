@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkHighlights(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet string
+		want    string
+	}{
+		{
+			name:    "plain text",
+			snippet: "buy milk",
+			want:    "buy milk",
+		},
+		{
+			name:    "match",
+			snippet: "buy " + highlightStart + "milk" + highlightStop,
+			want:    "buy <mark>milk</mark>",
+		},
+		{
+			name:    "markup in the todo is escaped",
+			snippet: `<img src=x onerror="alert(1)"> ` + highlightStart + "milk" + highlightStop,
+			want:    `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>milk</mark>`,
+		},
+		{
+			name:    "markup inside a match is escaped",
+			snippet: highlightStart + "<script>" + highlightStop,
+			want:    "<mark>&lt;script&gt;</mark>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := markHighlights(tt.snippet); got != tt.want {
+				t.Errorf("markHighlights(%q) = %q, want %q", tt.snippet, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildTSQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantExpr string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "empty",
+			input:    "   ",
+			wantExpr: "",
+		},
+		{
+			name:     "words",
+			input:    "buy milk",
+			wantExpr: "(plainto_tsquery('simple', ?) && plainto_tsquery('simple', ?))",
+			wantArgs: []interface{}{"buy", "milk"},
+		},
+		{
+			name:     "phrase",
+			input:    `"buy milk" today`,
+			wantExpr: "(phraseto_tsquery('simple', ?) && plainto_tsquery('simple', ?))",
+			wantArgs: []interface{}{"buy milk", "today"},
+		},
+		{
+			name:     "prefix is quoted as a lexeme",
+			input:    "mi'lk*",
+			wantExpr: "(to_tsquery('simple', ?))",
+			wantArgs: []interface{}{"'mi''lk':*"},
+		},
+		{
+			name:     "lone star is ignored",
+			input:    "*",
+			wantExpr: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, args := buildTSQuery(tt.input)
+			if expr != tt.wantExpr {
+				t.Errorf("buildTSQuery(%q) expression = %q, want %q", tt.input, expr, tt.wantExpr)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("buildTSQuery(%q) args = %v, want %v", tt.input, args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// todoTagRow is a tag together with the todo it is attached to
+type todoTagRow struct {
+	TodoID uint
+	entity.Tag
+}
+
+// loadTags populates the Tags of every given todo with a single query,
+// instead of one query per todo
+func (r *todoRepository) loadTags(ctx context.Context, todos []entity.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(todos))
+	for _, t := range todos {
+		ids = append(ids, t.ID)
+	}
+
+	var rows []todoTagRow
+	result := database.Conn(ctx, r.db).
+		Table("tags").
+		Select("todo_tags.todo_id, tags.*").
+		Joins("JOIN todo_tags ON todo_tags.tag_id = tags.id").
+		Where("todo_tags.todo_id IN ?", ids).
+		Order("tags.name ASC").
+		Find(&rows)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+
+	byTodo := make(map[uint][]entity.Tag, len(todos))
+	for _, row := range rows {
+		byTodo[row.TodoID] = append(byTodo[row.TodoID], row.Tag)
+	}
+	for i := range todos {
+		todos[i].Tags = byTodo[todos[i].ID]
+		if todos[i].Tags == nil {
+			todos[i].Tags = []entity.Tag{}
+		}
+	}
+	return nil
+}
+
+// AttachTags links tags to a todo, ignoring tags already attached
+func (r *todoRepository) AttachTags(ctx context.Context, todoID uint, tagIDs []uint) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	links := make([]entity.TodoTag, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		links = append(links, entity.TodoTag{TodoID: todoID, TagID: tagID})
+	}
+
+	result := database.Conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&links)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	return nil
+}
+
+// DetachTag unlinks a tag from a todo
+func (r *todoRepository) DetachTag(ctx context.Context, todoID, tagID uint) error {
+	result := database.Conn(ctx, r.db).
+		Where("todo_id = ? AND tag_id = ?", todoID, tagID).
+		Delete(&entity.TodoTag{})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// taggedWith scopes a todo query to todos carrying any or all of the named tags
+func taggedWith(names []string, matchAll bool) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		const tagged = "FROM todo_tags JOIN tags ON tags.id = todo_tags.tag_id " +
+			"WHERE todo_tags.todo_id = todos.id AND tags.name IN ?"
+		if matchAll {
+			return db.Where("(SELECT COUNT(DISTINCT tags.name) "+tagged+") = ?", names, len(names))
+		}
+		return db.Where("EXISTS (SELECT 1 "+tagged+")", names)
+	}
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+)
+
+// trackedRow is the tracked time of a single todo; running timers count up
+// to now
+type trackedRow struct {
+	TodoID  uint
+	Seconds float64
+}
+
+// loadTrackedTime populates the TrackedTime of every given todo
+func (r *todoRepository) loadTrackedTime(ctx context.Context, todos []entity.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(todos))
+	for _, t := range todos {
+		ids = append(ids, t.ID)
+	}
+
+	var rows []trackedRow
+	result := database.Conn(ctx, r.db).
+		Model(&entity.TimeEntry{}).
+		Select("todo_id, SUM(EXTRACT(EPOCH FROM COALESCE(ended_at, NOW()) - started_at)) AS seconds").
+		Where("todo_id IN ?", ids).
+		Group("todo_id").
+		Find(&rows)
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+
+	byTodo := make(map[uint]time.Duration, len(rows))
+	for _, row := range rows {
+		byTodo[row.TodoID] = time.Duration(math.Round(row.Seconds)) * time.Second
+	}
+	for i := range todos {
+		todos[i].TrackedTime = byTodo[todos[i].ID]
+	}
+	return nil
+}
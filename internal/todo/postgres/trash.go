@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// trashed scopes an unscoped query to soft-deleted todos
+func trashed(db *gorm.DB) *gorm.DB {
+	return db.Where("deleted_at IS NOT NULL")
+}
+
+// FindTrashed retrieves one page of the user's soft-deleted todos, most
+// recently deleted first
+func (r *todoRepository) FindTrashed(ctx context.Context, userID uint, limit, offset int) ([]entity.Todo, int64, error) {
+	var total int64
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Model(&entity.Todo{}).
+		Scopes(ownedBy(userID), trashed).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+
+	var todos []entity.Todo
+	result = database.Conn(ctx, r.db).
+		Unscoped().
+		Scopes(ownedBy(userID), trashed).
+		Order("deleted_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&todos)
+	if result.Error != nil {
+		return nil, 0, domain.ErrDatabaseOperation
+	}
+	if err := r.hydrate(ctx, todos); err != nil {
+		return nil, 0, err
+	}
+	return todos, total, nil
+}
+
+// Restore moves a soft-deleted todo out of the trash and advances its version
+func (r *todoRepository) Restore(ctx context.Context, userID, id uint) error {
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Model(&entity.Todo{}).
+		Scopes(ownedBy(userID), trashed).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		return domain.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// DeletePermanently removes a soft-deleted todo for good
+func (r *todoRepository) DeletePermanently(ctx context.Context, userID, id uint) (*entity.Todo, error) {
+	var todos []entity.Todo
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Clauses(clause.Returning{}).
+		Scopes(ownedBy(userID), trashed).
+		Where("id = ?", id).
+		Delete(&todos)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	if len(todos) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return &todos[0], nil
+}
+
+// LockTrashed returns the IDs of the todos soft-deleted before the given
+// time, locked against concurrent restores
+func (r *todoRepository) LockTrashed(ctx context.Context, before time.Time) ([]uint, error) {
+	var ids []uint
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Model(&entity.Todo{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_at < ?", before).
+		Pluck("id", &ids)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return ids, nil
+}
+
+// PurgeTrashed permanently deletes every todo soft-deleted before the given time
+func (r *todoRepository) PurgeTrashed(ctx context.Context, before time.Time) ([]entity.Todo, error) {
+	var todos []entity.Todo
+	result := database.Conn(ctx, r.db).
+		Unscoped().
+		Clauses(clause.Returning{}).
+		Where("deleted_at < ?", before).
+		Delete(&todos)
+	if result.Error != nil {
+		return nil, domain.ErrDatabaseOperation
+	}
+	return todos, nil
+}
@@ -0,0 +1,102 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// GetAllByProject retrieves one page of the todos in a project the user is
+// a member of. Without a requested sort field the project's default sort
+// field and direction apply.
+func (s *Service) GetAllByProject(ctx context.Context, userID, projectID uint, input ListTodosInput) (*TodoPage, error) {
+	if userID == 0 || projectID == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	project, err := s.projects.FindByID(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	input.ProjectID = &project.ID
+	if input.SortBy == "" {
+		input.SortBy = project.DefaultSort
+		if input.SortOrder == "" {
+			input.SortOrder = project.DefaultOrder
+		}
+	}
+	return s.GetAll(ctx, userID, input)
+}
+
+// Move moves a todo the user may change to a project they can edit, or to
+// the inbox of the todo's owner when projectID is nil. Archived projects
+// accept no todos and unknown projects are reported as not found. The todo
+// keeps its status when the target workflow has it and otherwise moves to
+// the target's done or initial status. When version is set the todo is
+// only moved at that version.
+func (s *Service) Move(ctx context.Context, userID, id uint, projectID, version *uint) (*entity.Todo, error) {
+	if userID == 0 || id == 0 || (projectID != nil && *projectID == 0) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var todo *entity.Todo
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		todo, err = s.access.FindWritable(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if version != nil && *version != todo.Version {
+			return domain.ErrVersionConflict
+		}
+
+		if projectID != nil {
+			if err := s.checkProjectEditor(ctx, userID, *projectID); err != nil {
+				return err
+			}
+		}
+
+		workflow, err := s.workflowOf(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		before := *todo
+		if err := s.moveToList(ctx, todo, projectID); err != nil {
+			return err
+		}
+		setStatus(todo, workflow.Fit(todo.Status, todo.Completed), time.Now())
+		if err := s.repo.Update(ctx, todo); err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoUpdated, &before, todo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// checkProjectEditor checks that a project accepts todos from the user: it
+// is not archived and the user is at least an editor of it
+func (s *Service) checkProjectEditor(ctx context.Context, userID, projectID uint) error {
+	project, err := s.projects.FindByID(ctx, userID, projectID)
+	if err != nil {
+		return err
+	}
+	if project.Archived {
+		return domain.ErrInvalidInput
+	}
+	member, err := s.members.Find(ctx, project.ID, userID)
+	if err != nil {
+		return err
+	}
+	if !member.Role.Allows(entity.RoleEditor) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
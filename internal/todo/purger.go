@@ -0,0 +1,57 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/pkg/logger"
+)
+
+// Purger periodically empties the trash of todos older than the retention period
+type Purger struct {
+	service   *Service
+	retention time.Duration
+	interval  time.Duration
+	log       *logger.Logger
+}
+
+// NewPurger creates a new trash purger
+func NewPurger(service *Service, retention, interval time.Duration, log *logger.Logger) *Purger {
+	return &Purger{
+		service:   service,
+		retention: retention,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// Run purges the trash immediately and then on every interval until the
+// context is cancelled. Failed runs are logged and retried on the next tick.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge runs a single purge and logs its outcome
+func (p *Purger) purge(ctx context.Context) {
+	purged, err := p.service.PurgeTrash(ctx, p.retention)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.log.Error("Failed to purge trashed todos: %v", err)
+		}
+		return
+	}
+	if purged > 0 {
+		p.log.Info("Purged %d trashed todos", purged)
+	}
+}
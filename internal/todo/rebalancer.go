@@ -0,0 +1,58 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/pkg/logger"
+)
+
+// Rebalancer periodically shortens todo positions that have grown past a
+// length limit through repeated moves into the same gap
+type Rebalancer struct {
+	service   *Service
+	maxLength int
+	interval  time.Duration
+	log       *logger.Logger
+}
+
+// NewRebalancer creates a new position rebalancer
+func NewRebalancer(service *Service, maxLength int, interval time.Duration, log *logger.Logger) *Rebalancer {
+	return &Rebalancer{
+		service:   service,
+		maxLength: maxLength,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// Run checks the positions immediately and then on every interval until
+// the context is cancelled. Failed runs are logged and retried on the next tick.
+func (r *Rebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.rebalance(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rebalance runs a single check and logs its outcome
+func (r *Rebalancer) rebalance(ctx context.Context) {
+	rebalanced, err := r.service.RebalancePositions(ctx, r.maxLength)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.log.Error("Failed to rebalance todo positions: %v", err)
+		}
+		return
+	}
+	if rebalanced > 0 {
+		r.log.Info("Rebalanced the positions of %d todos", rebalanced)
+	}
+}
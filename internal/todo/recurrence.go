@@ -0,0 +1,158 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/pkg/rrule"
+)
+
+const (
+	// DefaultOccurrences is the number of occurrences previewed when none is requested
+	DefaultOccurrences = 5
+	// MaxOccurrences is the largest number of occurrences a caller may preview
+	MaxOccurrences = 50
+)
+
+// Occurrences previews the next count occurrences of a recurring todo after
+// its due date, in the todo's timezone. Todos that do not recur have none.
+func (s *Service) Occurrences(ctx context.Context, userID, id uint, count int) ([]time.Time, error) {
+	if userID == 0 || id == 0 || count < 0 || count > MaxOccurrences {
+		return nil, domain.ErrInvalidInput
+	}
+	if count == 0 {
+		count = DefaultOccurrences
+	}
+
+	todo, err := s.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if todo.Recurrence == "" || todo.DueAt == nil {
+		return []time.Time{}, nil
+	}
+
+	rule, loc, err := parseRecurrence(todo.Recurrence, todo.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule.Occurrences(todo.DueAt.In(loc), count), nil
+}
+
+// scheduleNext creates the occurrence following a recurring todo that has
+// just been completed. The next occurrence copies the todo's details, tags,
+// checklist (reopened) and reminders relative to its due date, and is due
+// on the rule's first date after the todo's due date, so completing late
+// does not shift the series. Ended series and todos whose next occurrence
+// already exists are left alone. The creation is recorded as made by
+// actorID.
+func (s *Service) scheduleNext(ctx context.Context, actorID uint, todo *entity.Todo) error {
+	if todo.Recurrence == "" || todo.DueAt == nil || todo.NextOccurrenceID != nil {
+		return nil
+	}
+
+	rule, loc, err := parseRecurrence(todo.Recurrence, todo.Timezone)
+	if err != nil {
+		return err
+	}
+	dueAt, ok := rule.Next(todo.DueAt.In(loc))
+	if !ok {
+		return nil
+	}
+
+	// The next occurrence starts what remains of the series
+	if rule.Count > 0 {
+		rule.Count--
+	}
+
+	workflow, err := s.workflowOf(ctx, todo.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	next := &entity.Todo{
+		UserID:       todo.UserID,
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Priority:     todo.Priority,
+		Status:       workflow.Initial().Key,
+		DueAt:        &dueAt,
+		AutoComplete: todo.AutoComplete,
+		Recurrence:   rule.String(),
+		Timezone:     todo.Timezone,
+		ProjectID:    todo.ProjectID,
+	}
+	if err := s.repo.Create(ctx, next); err != nil {
+		return err
+	}
+
+	if len(todo.Tags) > 0 {
+		tagIDs := make([]uint, 0, len(todo.Tags))
+		for _, tag := range todo.Tags {
+			tagIDs = append(tagIDs, tag.ID)
+		}
+		if err := s.repo.AttachTags(ctx, next.ID, tagIDs); err != nil {
+			return err
+		}
+	}
+
+	next.Tags = todo.Tags
+
+	items, err := s.items.FindByTodo(ctx, todo.ID)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		copied := &entity.ChecklistItem{
+			TodoID:   next.ID,
+			Title:    item.Title,
+			Position: item.Position,
+		}
+		if err := s.items.Create(ctx, copied); err != nil {
+			return err
+		}
+	}
+
+	if err := s.occurrences.CopyToOccurrence(ctx, todo, next); err != nil {
+		return err
+	}
+
+	todo.NextOccurrenceID = &next.ID
+	return s.record(ctx, actorID, entity.TodoCreated, nil, next)
+}
+
+// normalizeRecurrence validates a recurrence rule and timezone and returns
+// them in canonical form. The timezone defaults to UTC.
+func normalizeRecurrence(recurrence, timezone string) (string, string, error) {
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if recurrence == "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return "", "", domain.ErrInvalidInput
+		}
+		return "", timezone, nil
+	}
+
+	rule, _, err := parseRecurrence(recurrence, timezone)
+	if err != nil {
+		return "", "", err
+	}
+	return rule.String(), timezone, nil
+}
+
+// parseRecurrence parses a stored recurrence rule and its timezone
+func parseRecurrence(recurrence, timezone string) (*rrule.Rule, *time.Location, error) {
+	rule, err := rrule.Parse(recurrence)
+	if err != nil {
+		return nil, nil, domain.ErrInvalidInput
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, domain.ErrInvalidInput
+	}
+	return rule, loc, nil
+}
@@ -3,61 +3,507 @@ package todo
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/arulkarim/golden-architecture/internal/domain"
 	"github.com/arulkarim/golden-architecture/internal/domain/contract"
 	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/tag"
+)
+
+const (
+	// DefaultPageSize is the page size used when none is requested
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page size a caller may request
+	MaxPageSize = 100
 )
 
 // Service provides todo business logic
 type Service struct {
-	repo contract.TodoRepository
+	repo         contract.TodoRepository
+	tags         contract.TagRepository
+	tagService   *tag.Service
+	items        contract.ChecklistRepository
+	events       contract.TodoEventRepository
+	dependencies contract.TodoDependencyRepository
+	projects     contract.ProjectRepository
+	members      contract.ProjectMemberRepository
+	access       *Access
+	purge        PurgeHook
+	occurrences  OccurrenceHook
+	tx           contract.Transactor
+}
+
+// Deps holds what the todo service depends on. Tags named on import or
+// creation are created through TagService, so they follow the same rules
+// as tags created directly. Access decides who may see and change todos;
+// the hooks let the modules hanging data off todos take
+// part in purging todos and in creating the next occurrence of a
+// recurring one.
+type Deps struct {
+	Todos        contract.TodoRepository
+	Tags         contract.TagRepository
+	TagService   *tag.Service
+	Checklist    contract.ChecklistRepository
+	Events       contract.TodoEventRepository
+	Dependencies contract.TodoDependencyRepository
+	Projects     contract.ProjectRepository
+	Members      contract.ProjectMemberRepository
+	Access       *Access
+	Purge        PurgeHook
+	Occurrences  OccurrenceHook
+	Transactor   contract.Transactor
+}
+
+// PurgeHook removes what another module keeps for todos that are deleted
+// for good. PurgeTodos runs in the transaction deleting the todos, before
+// they are deleted; the func it returns runs last in that transaction.
+type PurgeHook interface {
+	PurgeTodos(ctx context.Context, todoIDs []uint) (func(ctx context.Context) error, error)
+}
+
+// OccurrenceHook carries what another module keeps for a recurring todo
+// over to its next occurrence. CopyToOccurrence runs in the transaction
+// creating the occurrence.
+type OccurrenceHook interface {
+	CopyToOccurrence(ctx context.Context, todo, next *entity.Todo) error
 }
 
 // NewService creates a new todo service
-func NewService(repo contract.TodoRepository) *Service {
-	return &Service{repo: repo}
+func NewService(deps Deps) *Service {
+	return &Service{
+		repo:         deps.Todos,
+		tags:         deps.Tags,
+		tagService:   deps.TagService,
+		items:        deps.Checklist,
+		events:       deps.Events,
+		dependencies: deps.Dependencies,
+		projects:     deps.Projects,
+		members:      deps.Members,
+		access:       deps.Access,
+		purge:        deps.Purge,
+		occurrences:  deps.Occurrences,
+		tx:           deps.Transactor,
+	}
 }
 
-// CreateTodoInput represents input for creating a todo
+// CreateTodoInput represents input for creating a todo.
+// Priority defaults to medium when empty. Recurrence is an RRULE evaluated
+// in Timezone (UTC when empty) and requires a due date.
 type CreateTodoInput struct {
-	Title       string
-	Description string
+	Title        string
+	Description  string
+	Priority     entity.TodoPriority
+	DueAt        *time.Time
+	AutoComplete bool
+	Recurrence   string
+	Timezone     string
 }
 
-// UpdateTodoInput represents input for updating a todo
+// UpdateTodoInput represents input for updating a todo.
+// When Version is set the update only applies to that version of the todo.
+// ClearDueAt removes the due date. Status moves the todo along its
+// workflow; Completed, when also set, must agree with it. Completing a todo
+// with open blockers fails with ErrBlocked unless Force is set.
 type UpdateTodoInput struct {
-	Title       *string
-	Description *string
+	Title        *string
+	Description  *string
+	Completed    *bool
+	Status       *string
+	Priority     *entity.TodoPriority
+	DueAt        *time.Time
+	ClearDueAt   bool
+	AutoComplete *bool
+	Recurrence   *string
+	Timezone     *string
+	Version      *uint
+	Force        bool
+}
+
+// ListTodosInput represents input for listing todos.
+// Due selects a due date window (see DueOverdue, DueToday, DueThisWeek)
+// evaluated in Location, which defaults to UTC. Tags filters by tag name,
+// matching any of them unless TagMatch is contract.TagMatchAll. ProjectID
+// restricts the listing to one project and Status to one workflow status.
+// Ready keeps the open todos that have no open blockers.
+type ListTodosInput struct {
+	ProjectID   *uint
+	Status      string
+	Page        int
+	PageSize    int
 	Completed   *bool
+	Priority    entity.TodoPriority
+	Tags        []string
+	TagMatch    string
+	Due         string
+	Location    *time.Location
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	Ready       bool
+	SortBy      string
+	SortOrder   string
+}
+
+// TodoPage represents one page of a todo listing
+type TodoPage struct {
+	Todos    []entity.Todo
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+// TotalPages returns the number of pages available for the listing
+func (p *TodoPage) TotalPages() int {
+	if p.PageSize == 0 {
+		return 0
+	}
+	return int((p.Total + int64(p.PageSize) - 1) / int64(p.PageSize))
+}
+
+// TodoCursor identifies a position in the newest-first todo listing.
+// Backward cursors page towards newer todos, forward cursors towards older ones.
+type TodoCursor struct {
+	Key      contract.TodoKey
+	Backward bool
+}
+
+// TodoCursorPage represents one keyset page of a todo listing
+type TodoCursorPage struct {
+	Todos    []entity.Todo
+	PageSize int
+	Next     *TodoCursor
+	Prev     *TodoCursor
+}
+
+// SearchTodosInput represents input for a full-text todo search
+type SearchTodosInput struct {
+	Query    string
+	Page     int
+	PageSize int
+}
+
+// TodoSearchPage represents one page of full-text search results
+type TodoSearchPage struct {
+	Results  []contract.TodoSearchResult
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+// TotalPages returns the number of pages available for the search
+func (p *TodoSearchPage) TotalPages() int {
+	return (&TodoPage{Total: p.Total, PageSize: p.PageSize}).TotalPages()
+}
+
+// Create creates a new todo owned by the given user
+func (s *Service) Create(ctx context.Context, userID uint, input CreateTodoInput) (*entity.Todo, error) {
+	todo, err := newTodo(userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, todo); err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoCreated, nil, todo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// GetByID retrieves a todo by ID.
+// Todos owned by other users are reported as not found.
+func (s *Service) GetByID(ctx context.Context, userID, id uint) (*entity.Todo, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	todo, err := s.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// GetAll retrieves one page of the todos owned by the given user
+func (s *Service) GetAll(ctx context.Context, userID uint, input ListTodosInput) (*TodoPage, error) {
+	filter, err := listFilter(userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	page := input.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := pageSizeOrDefault(input.PageSize)
+	sortBy := input.SortBy
+	if sortBy == "" {
+		sortBy = contract.TodoSortCreatedAt
+	}
+	sortOrder := input.SortOrder
+	if sortOrder == "" {
+		sortOrder = contract.SortDesc
+		if sortBy == contract.TodoSortPosition {
+			sortOrder = contract.SortAsc
+		}
+	}
+
+	query := contract.TodoQuery{
+		TodoFilter: filter,
+		SortBy:     sortBy,
+		SortOrder:  sortOrder,
+		Limit:      pageSize,
+		Offset:     (page - 1) * pageSize,
+	}
+
+	todos, total, err := s.repo.FindAll(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TodoPage{
+		Todos:    todos,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// GetAllByCursor retrieves one keyset page of the todos owned by the given
+// user, newest first. A nil cursor starts at the newest todo. Page and sort
+// options of the input are not supported in cursor mode.
+func (s *Service) GetAllByCursor(ctx context.Context, userID uint, input ListTodosInput, cursor *TodoCursor) (*TodoCursorPage, error) {
+	filter, err := listFilter(userID, input)
+	if err != nil {
+		return nil, err
+	}
+	if input.Page > 1 ||
+		(input.SortBy != "" && input.SortBy != contract.TodoSortCreatedAt) ||
+		(input.SortOrder != "" && input.SortOrder != contract.SortDesc) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	pageSize := pageSizeOrDefault(input.PageSize)
+	query := contract.TodoCursorQuery{
+		TodoFilter: filter,
+		Limit:      pageSize,
+	}
+	backward := cursor != nil && cursor.Backward
+	if cursor != nil {
+		key := cursor.Key
+		if backward {
+			query.Before = &key
+		} else {
+			query.After = &key
+		}
+	}
+
+	todos, hasMore, err := s.repo.FindByCursor(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TodoCursorPage{
+		Todos:    todos,
+		PageSize: pageSize,
+	}
+	if len(todos) == 0 {
+		return page, nil
+	}
+
+	first, last := todos[0], todos[len(todos)-1]
+	next := &TodoCursor{Key: contract.TodoKey{CreatedAt: last.CreatedAt, ID: last.ID}}
+	prev := &TodoCursor{Key: contract.TodoKey{CreatedAt: first.CreatedAt, ID: first.ID}, Backward: true}
+	if backward {
+		// We walked back from a later page, so older todos always follow
+		page.Next = next
+		if hasMore {
+			page.Prev = prev
+		}
+	} else {
+		if hasMore {
+			page.Next = next
+		}
+		if cursor != nil {
+			page.Prev = prev
+		}
+	}
+
+	return page, nil
 }
 
-// Create creates a new todo
-func (s *Service) Create(ctx context.Context, input CreateTodoInput) (*entity.Todo, error) {
-	if input.Title == "" {
+// Search runs a ranked full-text search over the todos owned by the given user
+func (s *Service) Search(ctx context.Context, userID uint, input SearchTodosInput) (*TodoSearchPage, error) {
+	query := strings.TrimSpace(input.Query)
+	if userID == 0 || query == "" || input.Page < 0 || input.PageSize < 0 || input.PageSize > MaxPageSize {
 		return nil, domain.ErrInvalidInput
 	}
 
-	todo := &entity.Todo{
-		Title:       input.Title,
-		Description: input.Description,
-		Completed:   false,
+	page := input.Page
+	if page == 0 {
+		page = 1
 	}
+	pageSize := pageSizeOrDefault(input.PageSize)
 
-	if err := s.repo.Create(ctx, todo); err != nil {
+	results, total, err := s.repo.Search(ctx, contract.TodoSearchQuery{
+		UserID: userID,
+		Query:  query,
+		Limit:  pageSize,
+		Offset: (page - 1) * pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TodoSearchPage{
+		Results:  results,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// TodoPatch derives the update to apply to a todo from its current state
+type TodoPatch func(current *entity.Todo) (UpdateTodoInput, error)
+
+// Update updates an existing todo owned by the given user.
+// Completing an occurrence of a recurring todo creates the next occurrence.
+func (s *Service) Update(ctx context.Context, userID, id uint, input UpdateTodoInput) (*entity.Todo, error) {
+	return s.Patch(ctx, userID, id, input.Version, func(*entity.Todo) (UpdateTodoInput, error) {
+		return input, nil
+	})
+}
+
+// Patch updates an existing todo owned by the given user with an update
+// derived from its current state. The todo is read, patched and written in
+// a single transaction; an error from patch aborts it. When version is set
+// the todo is only patched at that version.
+func (s *Service) Patch(ctx context.Context, userID, id uint, version *uint, patch TodoPatch) (*entity.Todo, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var todo *entity.Todo
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		todo, err = s.access.FindWritable(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+
+		if version != nil && *version != todo.Version {
+			return domain.ErrVersionConflict
+		}
+
+		input, err := patch(todo)
+		if err != nil {
+			return err
+		}
+
+		workflow, err := s.workflowOf(ctx, todo.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		before := *todo
+		if err := applyUpdate(todo, input, workflow, time.Now()); err != nil {
+			return err
+		}
+		if todo.Completed && !before.Completed {
+			if !input.Force {
+				if err := s.checkUnblocked(ctx, todo.ID); err != nil {
+					return err
+				}
+			}
+			if err := s.scheduleNext(ctx, userID, todo); err != nil {
+				return err
+			}
+		}
+
+		if err := s.repo.Update(ctx, todo); err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoUpdated, &before, todo)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return todo, nil
 }
 
-// GetByID retrieves a todo by ID
-func (s *Service) GetByID(ctx context.Context, id uint) (*entity.Todo, error) {
-	if id == 0 {
+// Delete moves a todo owned by the given user to the trash.
+// When version is set the todo is only deleted at that version.
+func (s *Service) Delete(ctx context.Context, userID, id uint, version *uint) error {
+	if userID == 0 || id == 0 || (version != nil && *version == 0) {
+		return domain.ErrInvalidInput
+	}
+
+	var expected uint
+	if version != nil {
+		expected = *version
+	}
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		todo, err := s.access.FindWritable(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.Delete(ctx, userID, id, expected); err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoDeleted, nil, todo)
+	})
+}
+
+// AttachTags attaches the user's tags to one of their todos and returns
+// the todo with its updated tags. Unknown tags or tags owned by other
+// users are reported as not found.
+func (s *Service) AttachTags(ctx context.Context, userID, id uint, tagIDs []uint) (*entity.Todo, error) {
+	if userID == 0 || id == 0 || len(tagIDs) == 0 {
 		return nil, domain.ErrInvalidInput
 	}
 
-	todo, err := s.repo.FindByID(ctx, id)
+	tagIDs = slices.Compact(slices.Sorted(slices.Values(tagIDs)))
+
+	var todo *entity.Todo
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.access.FindWritable(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+
+		tags, err := s.tags.FindByIDs(ctx, userID, tagIDs)
+		if err != nil {
+			return err
+		}
+		if len(tags) != len(tagIDs) {
+			return domain.ErrNotFound
+		}
+
+		if err := s.repo.AttachTags(ctx, id, tagIDs); err != nil {
+			return err
+		}
+		if err := s.repo.Touch(ctx, id); err != nil {
+			return err
+		}
+
+		todo, err = s.repo.FindByID(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoUpdated, before, todo)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -65,21 +511,94 @@ func (s *Service) GetByID(ctx context.Context, id uint) (*entity.Todo, error) {
 	return todo, nil
 }
 
-// GetAll retrieves all todos
-func (s *Service) GetAll(ctx context.Context) ([]entity.Todo, error) {
-	return s.repo.FindAll(ctx)
+// DetachTag removes a tag from one of the user's todos
+func (s *Service) DetachTag(ctx context.Context, userID, id, tagID uint) error {
+	if userID == 0 || id == 0 || tagID == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.access.FindWritable(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.DetachTag(ctx, id, tagID); err != nil {
+			return err
+		}
+		if err := s.repo.Touch(ctx, id); err != nil {
+			return err
+		}
+
+		todo, err := s.repo.FindByID(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoUpdated, before, todo)
+	})
 }
 
-// Update updates an existing todo
-func (s *Service) Update(ctx context.Context, id uint, input UpdateTodoInput) (*entity.Todo, error) {
-	if id == 0 {
+// newTodo validates the input for a new todo and builds it
+func newTodo(userID uint, input CreateTodoInput) (*entity.Todo, error) {
+	if userID == 0 || input.Title == "" {
 		return nil, domain.ErrInvalidInput
 	}
 
-	todo, err := s.repo.FindByID(ctx, id)
+	priority := input.Priority
+	if priority == "" {
+		priority = entity.PriorityMedium
+	}
+	if !priority.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	recurrence, timezone, err := normalizeRecurrence(input.Recurrence, input.Timezone)
 	if err != nil {
 		return nil, err
 	}
+	if recurrence != "" && input.DueAt == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return &entity.Todo{
+		UserID:       userID,
+		Title:        input.Title,
+		Description:  input.Description,
+		Completed:    false,
+		Status:       entity.DefaultWorkflow().Initial().Key,
+		Priority:     priority,
+		DueAt:        input.DueAt,
+		AutoComplete: input.AutoComplete,
+		Recurrence:   recurrence,
+		Timezone:     timezone,
+	}, nil
+}
+
+// applyUpdate applies the provided fields of an update to a todo whose
+// status belongs to the given workflow (see applyStatus). An empty
+// Recurrence stops the todo from recurring.
+func applyUpdate(todo *entity.Todo, input UpdateTodoInput, workflow entity.Workflow, now time.Time) error {
+	if input.Title != nil && *input.Title == "" {
+		return domain.ErrInvalidInput
+	}
+	if input.Priority != nil && !input.Priority.IsValid() {
+		return domain.ErrInvalidInput
+	}
+	if input.Recurrence != nil || input.Timezone != nil {
+		recurrence, timezone := todo.Recurrence, todo.Timezone
+		if input.Recurrence != nil {
+			recurrence = *input.Recurrence
+		}
+		if input.Timezone != nil {
+			timezone = *input.Timezone
+		}
+
+		var err error
+		todo.Recurrence, todo.Timezone, err = normalizeRecurrence(recurrence, timezone)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Update fields if provided
 	if input.Title != nil {
@@ -88,24 +607,80 @@ func (s *Service) Update(ctx context.Context, id uint, input UpdateTodoInput) (*
 	if input.Description != nil {
 		todo.Description = *input.Description
 	}
-	if input.Completed != nil {
-		todo.Completed = *input.Completed
+	if input.Priority != nil {
+		todo.Priority = *input.Priority
+	}
+	if input.DueAt != nil {
+		todo.DueAt = input.DueAt
+	}
+	if input.ClearDueAt {
+		todo.DueAt = nil
+	}
+	if input.AutoComplete != nil {
+		todo.AutoComplete = *input.AutoComplete
+	}
+	if todo.Recurrence != "" && todo.DueAt == nil {
+		return domain.ErrInvalidInput
 	}
 
-	if err := s.repo.Update(ctx, todo); err != nil {
-		return nil, err
+	return applyStatus(todo, input, workflow, now)
+}
+
+// listFilter validates the listing input and builds the repository filter
+func listFilter(userID uint, input ListTodosInput) (contract.TodoFilter, error) {
+	if userID == 0 || input.Page < 0 || input.PageSize < 0 || input.PageSize > MaxPageSize {
+		return contract.TodoFilter{}, domain.ErrInvalidInput
+	}
+	if !validRange(input.CreatedFrom, input.CreatedTo) || !validRange(input.UpdatedFrom, input.UpdatedTo) {
+		return contract.TodoFilter{}, domain.ErrInvalidInput
+	}
+	if input.Priority != "" && !input.Priority.IsValid() {
+		return contract.TodoFilter{}, domain.ErrInvalidInput
+	}
+	if input.TagMatch != "" && input.TagMatch != contract.TagMatchAny && input.TagMatch != contract.TagMatchAll {
+		return contract.TodoFilter{}, domain.ErrInvalidInput
+	}
+	// Repeated names would never match them all
+	var tags []string
+	if len(input.Tags) > 0 {
+		var err error
+		if tags, err = tagNames(input.Tags); err != nil {
+			return contract.TodoFilter{}, err
+		}
 	}
 
-	return todo, nil
+	filter := contract.TodoFilter{
+		UserID:      userID,
+		ProjectID:   input.ProjectID,
+		Status:      input.Status,
+		Completed:   input.Completed,
+		Priority:    input.Priority,
+		Tags:        tags,
+		TagMatch:    input.TagMatch,
+		CreatedFrom: input.CreatedFrom,
+		CreatedTo:   input.CreatedTo,
+		UpdatedFrom: input.UpdatedFrom,
+		UpdatedTo:   input.UpdatedTo,
+		Ready:       input.Ready,
+	}
+	if err := applyDueWindow(&filter, input.Due, input.Location, time.Now()); err != nil {
+		return contract.TodoFilter{}, err
+	}
+
+	return filter, nil
 }
 
-// Delete deletes a todo by ID
-func (s *Service) Delete(ctx context.Context, id uint) error {
-	if id == 0 {
-		return domain.ErrInvalidInput
+// pageSizeOrDefault applies the default page size when none was requested
+func pageSizeOrDefault(pageSize int) int {
+	if pageSize == 0 {
+		return DefaultPageSize
 	}
+	return pageSize
+}
 
-	return s.repo.Delete(ctx, id)
+// validRange reports whether an optional time range is well-formed
+func validRange(from, to *time.Time) bool {
+	return from == nil || to == nil || !from.After(*to)
 }
 
 // IsNotFound checks if error is a not found error
@@ -117,3 +692,38 @@ func IsNotFound(err error) bool {
 func IsInvalidInput(err error) bool {
 	return errors.Is(err, domain.ErrInvalidInput)
 }
+
+// IsDuplicate checks if error is caused by a tag created by a concurrent
+// request while the todo service was creating it
+func IsDuplicate(err error) bool {
+	return errors.Is(err, domain.ErrDuplicateEntry)
+}
+
+// IsForbidden checks if error is caused by a project role lacking the
+// permission to change a todo
+func IsForbidden(err error) bool {
+	return errors.Is(err, domain.ErrForbidden)
+}
+
+// IsVersionConflict checks if error is caused by a stale todo version
+func IsVersionConflict(err error) bool {
+	return errors.Is(err, domain.ErrVersionConflict)
+}
+
+// IsDependencyCycle checks if error is caused by a link that would make a
+// todo transitively block itself
+func IsDependencyCycle(err error) bool {
+	return errors.Is(err, ErrDependencyCycle)
+}
+
+// IsBlocked checks if error is caused by completing a todo whose blockers
+// are still open
+func IsBlocked(err error) bool {
+	return errors.Is(err, ErrBlocked)
+}
+
+// IsTransitionNotAllowed checks if error is caused by a status change the
+// todo's workflow does not allow
+func IsTransitionNotAllowed(err error) bool {
+	return errors.Is(err, ErrTransitionNotAllowed)
+}
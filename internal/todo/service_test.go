@@ -0,0 +1,451 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/tag"
+)
+
+// fakeTx runs every function directly, as if its transaction committed
+type fakeTx struct{}
+
+func (fakeTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeMembers holds the project roles of users, keyed by project and user ID
+type fakeMembers struct {
+	contract.ProjectMemberRepository
+	roles map[uint]map[uint]entity.ProjectRole
+}
+
+func (f *fakeMembers) Find(_ context.Context, projectID, userID uint) (*entity.ProjectMember, error) {
+	role, ok := f.roles[projectID][userID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &entity.ProjectMember{ProjectID: projectID, UserID: userID, Role: role}, nil
+}
+
+// fakeProjects holds the workflows of projects; projects without one use
+// the default workflow
+type fakeProjects struct {
+	contract.ProjectRepository
+	workflows map[uint]entity.Workflow
+}
+
+func (f *fakeProjects) FindWorkflow(_ context.Context, id uint) (entity.Workflow, error) {
+	if workflow, ok := f.workflows[id]; ok {
+		return workflow, nil
+	}
+	return entity.DefaultWorkflow(), nil
+}
+
+// fakeTodos holds todos in memory. Todos outside the trash are visible to
+// their owner and to the members of their project. It records the last
+// listing query and the tags attached and detached.
+type fakeTodos struct {
+	contract.TodoRepository
+	todos      []entity.Todo
+	members    *fakeMembers
+	query      contract.TodoQuery
+	attached   []uint
+	detached   []uint
+	concurrent bool
+}
+
+// find returns the todo with the given ID visible to the user
+func (f *fakeTodos) find(userID, id uint) (*entity.Todo, error) {
+	for i := range f.todos {
+		todo := &f.todos[i]
+		if todo.ID != id || todo.DeletedAt.Valid {
+			continue
+		}
+		if todo.UserID == userID {
+			return todo, nil
+		}
+		if todo.ProjectID != nil && f.members != nil {
+			if _, ok := f.members.roles[*todo.ProjectID][userID]; ok {
+				return todo, nil
+			}
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeTodos) FindByID(_ context.Context, userID, id uint) (*entity.Todo, error) {
+	todo, err := f.find(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	found := *todo
+	return &found, nil
+}
+
+func (f *fakeTodos) AttachTags(_ context.Context, _ uint, tagIDs []uint) error {
+	f.attached = append(f.attached, tagIDs...)
+	return nil
+}
+
+func (f *fakeTodos) Touch(_ context.Context, id uint) error {
+	for i := range f.todos {
+		if f.todos[i].ID == id {
+			f.todos[i].Version++
+		}
+	}
+	return nil
+}
+
+// fakeTags holds the tags of every user
+type fakeTags struct {
+	contract.TagRepository
+	tags []entity.Tag
+}
+
+func (f *fakeTags) FindByIDs(_ context.Context, userID uint, ids []uint) ([]entity.Tag, error) {
+	var found []entity.Tag
+	for _, tag := range f.tags {
+		if tag.UserID == userID && slices.Contains(ids, tag.ID) {
+			found = append(found, tag)
+		}
+	}
+	return found, nil
+}
+
+// fakeEvents records the events appended
+type fakeEvents struct {
+	contract.TodoEventRepository
+	events []entity.TodoEvent
+}
+
+func (f *fakeEvents) Create(_ context.Context, event *entity.TodoEvent) error {
+	f.events = append(f.events, *event)
+	return nil
+}
+
+func (f *fakeEvents) CreateBatch(_ context.Context, events []entity.TodoEvent) error {
+	f.events = append(f.events, events...)
+	return nil
+}
+
+// FindAll records the query and returns every todo as a single page
+func (f *fakeTodos) FindAll(_ context.Context, query contract.TodoQuery) ([]entity.Todo, int64, error) {
+	f.query = query
+	return f.todos, int64(len(f.todos)), nil
+}
+
+func TestGetAll(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	completed := true
+
+	tests := []struct {
+		name    string
+		input   ListTodosInput
+		want    contract.TodoQuery
+		wantErr bool
+	}{
+		{
+			name:  "defaults",
+			input: ListTodosInput{},
+			want: contract.TodoQuery{
+				TodoFilter: contract.TodoFilter{UserID: 1},
+				SortBy:     contract.TodoSortCreatedAt,
+				SortOrder:  contract.SortDesc,
+				Limit:      DefaultPageSize,
+			},
+		},
+		{
+			name:  "page and page size",
+			input: ListTodosInput{Page: 3, PageSize: 10},
+			want: contract.TodoQuery{
+				TodoFilter: contract.TodoFilter{UserID: 1},
+				SortBy:     contract.TodoSortCreatedAt,
+				SortOrder:  contract.SortDesc,
+				Limit:      10,
+				Offset:     20,
+			},
+		},
+		{
+			name:  "position sorts ascending by default",
+			input: ListTodosInput{SortBy: contract.TodoSortPosition},
+			want: contract.TodoQuery{
+				TodoFilter: contract.TodoFilter{UserID: 1},
+				SortBy:     contract.TodoSortPosition,
+				SortOrder:  contract.SortAsc,
+				Limit:      DefaultPageSize,
+			},
+		},
+		{
+			name: "filters",
+			input: ListTodosInput{
+				Completed:   &completed,
+				Priority:    entity.PriorityHigh,
+				CreatedFrom: &from,
+				CreatedTo:   &to,
+				UpdatedFrom: &from,
+				SortBy:      contract.TodoSortTitle,
+				SortOrder:   contract.SortAsc,
+			},
+			want: contract.TodoQuery{
+				TodoFilter: contract.TodoFilter{
+					UserID:      1,
+					Completed:   &completed,
+					Priority:    entity.PriorityHigh,
+					CreatedFrom: &from,
+					CreatedTo:   &to,
+					UpdatedFrom: &from,
+				},
+				SortBy:    contract.TodoSortTitle,
+				SortOrder: contract.SortAsc,
+				Limit:     DefaultPageSize,
+			},
+		},
+		{
+			name:    "negative page",
+			input:   ListTodosInput{Page: -1},
+			wantErr: true,
+		},
+		{
+			name:    "page size too large",
+			input:   ListTodosInput{PageSize: MaxPageSize + 1},
+			wantErr: true,
+		},
+		{
+			name:    "created range reversed",
+			input:   ListTodosInput{CreatedFrom: &to, CreatedTo: &from},
+			wantErr: true,
+		},
+		{
+			name:    "updated range reversed",
+			input:   ListTodosInput{UpdatedFrom: &to, UpdatedTo: &from},
+			wantErr: true,
+		},
+		{
+			name:    "unknown priority",
+			input:   ListTodosInput{Priority: "asap"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos := &fakeTodos{todos: make([]entity.Todo, 45)}
+			s := NewService(Deps{Todos: todos})
+
+			page, err := s.GetAll(context.Background(), 1, tt.input)
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("GetAll() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetAll() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(todos.query, tt.want) {
+				t.Errorf("query = %+v, want %+v", todos.query, tt.want)
+			}
+			if page.PageSize != tt.want.Limit || page.Total != 45 {
+				t.Errorf("page size/total = %d/%d, want %d/45", page.PageSize, page.Total, tt.want.Limit)
+			}
+			if want := (45 + tt.want.Limit - 1) / tt.want.Limit; page.TotalPages() != want {
+				t.Errorf("TotalPages() = %d, want %d", page.TotalPages(), want)
+			}
+		})
+	}
+}
+
+func TestListFilterTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		tags      []string
+		match     string
+		wantTags  []string
+		wantMatch string
+		wantErr   bool
+	}{
+		{name: "no tags"},
+		{name: "trimmed", tags: []string{" work ", "home"}, wantTags: []string{"work", "home"}},
+		{name: "repeated names are dropped", tags: []string{"work", "work ", "home"}, match: contract.TagMatchAll, wantTags: []string{"work", "home"}, wantMatch: contract.TagMatchAll},
+		{name: "match any", tags: []string{"work"}, match: contract.TagMatchAny, wantTags: []string{"work"}, wantMatch: contract.TagMatchAny},
+		{name: "unknown match mode", tags: []string{"work"}, match: "some", wantErr: true},
+		{name: "empty name", tags: []string{"work", " "}, wantErr: true},
+		{name: "name too long", tags: []string{strings.Repeat("a", tag.MaxNameLength+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := listFilter(1, ListTodosInput{Tags: tt.tags, TagMatch: tt.match})
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("listFilter() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("listFilter() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(filter.Tags, tt.wantTags) || filter.TagMatch != tt.wantMatch {
+				t.Errorf("tags = %q (%q), want %q (%q)", filter.Tags, filter.TagMatch, tt.wantTags, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestAttachTags(t *testing.T) {
+	projectID := uint(3)
+
+	tests := []struct {
+		name         string
+		userID       uint
+		todoID       uint
+		tagIDs       []uint
+		wantAttached []uint
+		wantErr      error
+	}{
+		{name: "own tags", userID: 1, todoID: 10, tagIDs: []uint{2, 1, 2}, wantAttached: []uint{1, 2}},
+		{name: "editor of the project", userID: 2, todoID: 11, tagIDs: []uint{4}, wantAttached: []uint{4}},
+		{name: "no tags", userID: 1, todoID: 10, wantErr: domain.ErrInvalidInput},
+		{name: "tag of another user", userID: 1, todoID: 10, tagIDs: []uint{1, 4}, wantErr: domain.ErrNotFound},
+		{name: "unknown tag", userID: 1, todoID: 10, tagIDs: []uint{99}, wantErr: domain.ErrNotFound},
+		{name: "todo of another user", userID: 2, todoID: 10, tagIDs: []uint{4}, wantErr: domain.ErrNotFound},
+		{name: "viewer of the project", userID: 5, todoID: 11, tagIDs: []uint{5}, wantErr: domain.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := &fakeMembers{roles: map[uint]map[uint]entity.ProjectRole{
+				projectID: {1: entity.RoleOwner, 2: entity.RoleEditor, 5: entity.RoleViewer},
+			}}
+			todos := &fakeTodos{
+				todos: []entity.Todo{
+					{ID: 10, UserID: 1, Title: "Own", Version: 1},
+					{ID: 11, UserID: 1, Title: "Shared", ProjectID: &projectID, Version: 1},
+				},
+				members: members,
+			}
+			tags := &fakeTags{tags: []entity.Tag{
+				{ID: 1, UserID: 1, Name: "work"},
+				{ID: 2, UserID: 1, Name: "home"},
+				{ID: 4, UserID: 2, Name: "work"},
+				{ID: 5, UserID: 5, Name: "later"},
+			}}
+			events := &fakeEvents{}
+			s := NewService(Deps{
+				Todos:      todos,
+				Tags:       tags,
+				Events:     events,
+				Members:    members,
+				Access:     NewAccess(todos, members),
+				Transactor: fakeTx{},
+			})
+
+			_, err := s.AttachTags(context.Background(), tt.userID, tt.todoID, tt.tagIDs)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("AttachTags() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(todos.attached) > 0 {
+					t.Errorf("attached %v on error", todos.attached)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AttachTags() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(todos.attached, tt.wantAttached) {
+				t.Errorf("attached = %v, want %v", todos.attached, tt.wantAttached)
+			}
+			if todos.todos[tt.todoID-10].Version != 2 {
+				t.Errorf("version = %d, want the todo touched to 2", todos.todos[tt.todoID-10].Version)
+			}
+		})
+	}
+}
+
+// Update writes the todo unless the stored one moved past its version, and
+// advances the version. concurrent simulates a change committed between
+// the read and the write.
+func (f *fakeTodos) Update(_ context.Context, todo *entity.Todo) error {
+	stored, err := f.find(todo.UserID, todo.ID)
+	if err != nil {
+		return err
+	}
+	if f.concurrent {
+		stored.Version++
+	}
+	if stored.Version != todo.Version {
+		return domain.ErrVersionConflict
+	}
+	todo.Version++
+	*stored = *todo
+	return nil
+}
+
+func TestUpdateVersion(t *testing.T) {
+	version := func(v uint) *uint { return &v }
+	title := "Renamed"
+
+	tests := []struct {
+		name        string
+		version     *uint
+		concurrent  bool
+		wantVersion uint
+		wantErr     error
+	}{
+		{name: "without precondition", wantVersion: 4},
+		{name: "at the current version", version: version(3), wantVersion: 4},
+		{name: "at a stale version", version: version(2), wantErr: domain.ErrVersionConflict},
+		{name: "at a newer version", version: version(5), wantErr: domain.ErrVersionConflict},
+		{name: "changed between read and write", concurrent: true, wantErr: domain.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos := &fakeTodos{
+				todos:      []entity.Todo{{ID: 10, UserID: 1, Title: "Buy milk", Status: entity.StatusBacklog, Version: 3}},
+				concurrent: tt.concurrent,
+			}
+			events := &fakeEvents{}
+			s := NewService(Deps{
+				Todos:      todos,
+				Events:     events,
+				Access:     NewAccess(todos, &fakeMembers{}),
+				Transactor: fakeTx{},
+			})
+
+			todo, err := s.Update(context.Background(), 1, 10, UpdateTodoInput{Title: &title, Version: tt.version})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+				}
+				if todos.todos[0].Title != "Buy milk" {
+					t.Errorf("title = %q after a conflict, want it unchanged", todos.todos[0].Title)
+				}
+				if len(events.events) > 0 {
+					t.Errorf("recorded %d events on conflict", len(events.events))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() unexpected error: %v", err)
+			}
+			if todo.Title != title || todo.Version != tt.wantVersion {
+				t.Errorf("Update() = %q at version %d, want %q at %d", todo.Title, todo.Version, title, tt.wantVersion)
+			}
+			if len(events.events) != 1 || events.events[0].Version != tt.wantVersion {
+				t.Errorf("events = %+v, want one event at version %d", events.events, tt.wantVersion)
+			}
+		})
+	}
+}
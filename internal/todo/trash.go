@@ -0,0 +1,129 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+// ListTrashInput represents input for listing trashed todos
+type ListTrashInput struct {
+	Page     int
+	PageSize int
+}
+
+// ListTrash retrieves one page of the user's trashed todos, most recently
+// deleted first
+func (s *Service) ListTrash(ctx context.Context, userID uint, input ListTrashInput) (*TodoPage, error) {
+	if userID == 0 || input.Page < 0 || input.PageSize < 0 || input.PageSize > MaxPageSize {
+		return nil, domain.ErrInvalidInput
+	}
+
+	page := input.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := pageSizeOrDefault(input.PageSize)
+
+	todos, total, err := s.repo.FindTrashed(ctx, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TodoPage{
+		Todos:    todos,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// Restore moves one of the user's trashed todos out of the trash and
+// returns it
+func (s *Service) Restore(ctx context.Context, userID, id uint) (*entity.Todo, error) {
+	if userID == 0 || id == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var todo *entity.Todo
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Restore(ctx, userID, id); err != nil {
+			return err
+		}
+
+		var err error
+		todo, err = s.repo.FindByID(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, userID, entity.TodoRestored, nil, todo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// DeletePermanently removes one of the user's trashed todos for good,
+// together with its attachments. Todos must be in the trash first.
+func (s *Service) DeletePermanently(ctx context.Context, userID, id uint) error {
+	if userID == 0 || id == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		removeContent, err := s.purge.PurgeTodos(ctx, []uint{id})
+		if err != nil {
+			return err
+		}
+
+		todo, err := s.repo.DeletePermanently(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if err := s.record(ctx, userID, entity.TodoPurged, nil, todo); err != nil {
+			return err
+		}
+		return removeContent(ctx)
+	})
+}
+
+// PurgeTrash permanently removes every todo that has been in the trash for
+// longer than the retention period, together with its attachments, and
+// returns how many were removed
+func (s *Service) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, domain.ErrInvalidInput
+	}
+
+	before := time.Now().Add(-retention)
+	var purged []entity.Todo
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		ids, err := s.repo.LockTrashed(ctx, before)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		removeContent, err := s.purge.PurgeTodos(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		purged, err = s.repo.PurgeTrashed(ctx, before)
+		if err != nil {
+			return err
+		}
+		if err := s.recordAll(ctx, 0, entity.TodoPurged, nil, purged); err != nil {
+			return err
+		}
+		return removeContent(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(purged)), nil
+}
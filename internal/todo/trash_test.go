@@ -0,0 +1,294 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (f *fakeTodos) Delete(_ context.Context, userID, id, version uint) error {
+	todo, err := f.find(userID, id)
+	if err != nil {
+		return err
+	}
+	if version != 0 && todo.Version != version {
+		return domain.ErrVersionConflict
+	}
+	todo.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	return nil
+}
+
+// trashed returns the trashed todo with the given ID owned by the user
+func (f *fakeTodos) trashed(userID, id uint) (int, error) {
+	for i, todo := range f.todos {
+		if todo.ID == id && todo.UserID == userID && todo.DeletedAt.Valid {
+			return i, nil
+		}
+	}
+	return 0, domain.ErrNotFound
+}
+
+func (f *fakeTodos) Restore(_ context.Context, userID, id uint) error {
+	i, err := f.trashed(userID, id)
+	if err != nil {
+		return err
+	}
+	f.todos[i].DeletedAt = gorm.DeletedAt{}
+	return nil
+}
+
+func (f *fakeTodos) DeletePermanently(_ context.Context, userID, id uint) (*entity.Todo, error) {
+	i, err := f.trashed(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	todo := f.todos[i]
+	f.todos = slices.Delete(f.todos, i, i+1)
+	return &todo, nil
+}
+
+func (f *fakeTodos) LockTrashed(_ context.Context, before time.Time) ([]uint, error) {
+	var ids []uint
+	for _, todo := range f.todos {
+		if todo.DeletedAt.Valid && todo.DeletedAt.Time.Before(before) {
+			ids = append(ids, todo.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (f *fakeTodos) PurgeTrashed(_ context.Context, before time.Time) ([]entity.Todo, error) {
+	var purged []entity.Todo
+	f.todos = slices.DeleteFunc(f.todos, func(todo entity.Todo) bool {
+		old := todo.DeletedAt.Valid && todo.DeletedAt.Time.Before(before)
+		if old {
+			purged = append(purged, todo)
+		}
+		return old
+	})
+	return purged, nil
+}
+
+// fakePurge records the todos whose content was purged and whether the
+// content was removed
+type fakePurge struct {
+	todoIDs []uint
+	removed bool
+}
+
+func (f *fakePurge) PurgeTodos(_ context.Context, todoIDs []uint) (func(ctx context.Context) error, error) {
+	f.todoIDs = append(f.todoIDs, todoIDs...)
+	return func(context.Context) error {
+		f.removed = true
+		return nil
+	}, nil
+}
+
+// trashFixture returns a service over todo 10 owned by user 1, todo 11 in
+// project 3 where user 2 is an editor and user 5 a viewer, and todos 12
+// and 13 trashed an hour and a month ago
+func trashFixture() (*Service, *fakeTodos, *fakeEvents, *fakePurge) {
+	projectID := uint(3)
+	members := &fakeMembers{roles: map[uint]map[uint]entity.ProjectRole{
+		projectID: {1: entity.RoleOwner, 2: entity.RoleEditor, 5: entity.RoleViewer},
+	}}
+	now := time.Now()
+	todos := &fakeTodos{
+		todos: []entity.Todo{
+			{ID: 10, UserID: 1, Title: "Own", Version: 2},
+			{ID: 11, UserID: 1, Title: "Shared", ProjectID: &projectID, Version: 1},
+			{ID: 12, UserID: 1, Title: "Recent", Version: 1, DeletedAt: gorm.DeletedAt{Time: now.Add(-time.Hour), Valid: true}},
+			{ID: 13, UserID: 2, Title: "Old", Version: 1, DeletedAt: gorm.DeletedAt{Time: now.AddDate(0, -1, 0), Valid: true}},
+		},
+		members: members,
+	}
+	events := &fakeEvents{}
+	purge := &fakePurge{}
+	s := NewService(Deps{
+		Todos:      todos,
+		Events:     events,
+		Members:    members,
+		Access:     NewAccess(todos, members),
+		Purge:      purge,
+		Transactor: fakeTx{},
+	})
+	return s, todos, events, purge
+}
+
+func TestDelete(t *testing.T) {
+	version := func(v uint) *uint { return &v }
+
+	tests := []struct {
+		name    string
+		userID  uint
+		todoID  uint
+		version *uint
+		wantErr error
+	}{
+		{name: "own todo", userID: 1, todoID: 10},
+		{name: "at its version", userID: 1, todoID: 10, version: version(2)},
+		{name: "editor of the project", userID: 2, todoID: 11},
+		{name: "stale version", userID: 1, todoID: 10, version: version(1), wantErr: domain.ErrVersionConflict},
+		{name: "zero version", userID: 1, todoID: 10, version: version(0), wantErr: domain.ErrInvalidInput},
+		{name: "viewer of the project", userID: 5, todoID: 11, wantErr: domain.ErrForbidden},
+		{name: "todo of another user", userID: 2, todoID: 10, wantErr: domain.ErrNotFound},
+		{name: "already trashed", userID: 1, todoID: 12, wantErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, todos, events, _ := trashFixture()
+
+			err := s.Delete(context.Background(), tt.userID, tt.todoID, tt.version)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Delete() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(events.events) > 0 {
+					t.Errorf("recorded %d events on error", len(events.events))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Delete() unexpected error: %v", err)
+			}
+			if _, err := todos.find(tt.userID, tt.todoID); !errors.Is(err, domain.ErrNotFound) {
+				t.Errorf("todo still visible after delete")
+			}
+			if len(events.events) != 1 || events.events[0].Action != entity.TodoDeleted {
+				t.Errorf("events = %+v, want one deleted event", events.events)
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  uint
+		todoID  uint
+		wantErr error
+	}{
+		{name: "trashed todo", userID: 1, todoID: 12},
+		{name: "todo outside the trash", userID: 1, todoID: 10, wantErr: domain.ErrNotFound},
+		{name: "trashed todo of another user", userID: 1, todoID: 13, wantErr: domain.ErrNotFound},
+		{name: "unknown todo", userID: 1, todoID: 99, wantErr: domain.ErrNotFound},
+		{name: "no ID", userID: 1, wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, events, _ := trashFixture()
+
+			todo, err := s.Restore(context.Background(), tt.userID, tt.todoID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Restore() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Restore() unexpected error: %v", err)
+			}
+			if todo.ID != tt.todoID || todo.DeletedAt.Valid {
+				t.Errorf("Restore() = %+v, want todo %d out of the trash", todo, tt.todoID)
+			}
+			if len(events.events) != 1 || events.events[0].Action != entity.TodoRestored {
+				t.Errorf("events = %+v, want one restored event", events.events)
+			}
+		})
+	}
+}
+
+func TestDeletePermanently(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  uint
+		todoID  uint
+		wantErr error
+	}{
+		{name: "trashed todo", userID: 1, todoID: 12},
+		{name: "todo outside the trash", userID: 1, todoID: 10, wantErr: domain.ErrNotFound},
+		{name: "trashed todo of another user", userID: 1, todoID: 13, wantErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, todos, events, purge := trashFixture()
+
+			err := s.DeletePermanently(context.Background(), tt.userID, tt.todoID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("DeletePermanently() error = %v, want %v", err, tt.wantErr)
+				}
+				if purge.removed {
+					t.Error("content removed on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeletePermanently() unexpected error: %v", err)
+			}
+			if _, err := todos.trashed(tt.userID, tt.todoID); err == nil {
+				t.Error("todo still in the trash")
+			}
+			if !slices.Equal(purge.todoIDs, []uint{tt.todoID}) || !purge.removed {
+				t.Errorf("purged content of %v (removed %v), want %d", purge.todoIDs, purge.removed, tt.todoID)
+			}
+			if len(events.events) != 1 || events.events[0].Action != entity.TodoPurged {
+				t.Errorf("events = %+v, want one purged event", events.events)
+			}
+		})
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention time.Duration
+		want      []uint
+		wantErr   bool
+	}{
+		{name: "older than a week", retention: 7 * 24 * time.Hour, want: []uint{13}},
+		{name: "older than a minute", retention: time.Minute, want: []uint{12, 13}},
+		{name: "older than a year", retention: 365 * 24 * time.Hour},
+		{name: "no retention", retention: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, events, purge := trashFixture()
+
+			n, err := s.PurgeTrash(context.Background(), tt.retention)
+			if tt.wantErr {
+				if !IsInvalidInput(err) {
+					t.Fatalf("PurgeTrash() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PurgeTrash() unexpected error: %v", err)
+			}
+			if n != int64(len(tt.want)) || !slices.Equal(purge.todoIDs, tt.want) {
+				t.Errorf("PurgeTrash() = %d, purged %v, want %v", n, purge.todoIDs, tt.want)
+			}
+			if purge.removed != (len(tt.want) > 0) {
+				t.Errorf("content removed = %v, want %v", purge.removed, len(tt.want) > 0)
+			}
+			for _, event := range events.events {
+				if event.Action != entity.TodoPurged || event.ActorID != nil {
+					t.Errorf("event %+v, want a purged event without actor", event)
+				}
+			}
+			if len(events.events) != len(tt.want) {
+				t.Errorf("recorded %d events, want %d", len(events.events), len(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package todo
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+const (
+	// MaxTreeItems is the largest number of checklist items created with a todo
+	MaxTreeItems = 100
+	// MaxTreeTags is the largest number of tags attached to a todo on creation
+	MaxTreeTags = 20
+)
+
+// CreateTreeInput represents input for creating a todo together with its
+// checklist items and tags. Tags are matched by name and missing ones are
+// created. ProjectID places the todo in a project the user can edit
+// instead of their inbox.
+type CreateTreeInput struct {
+	CreateTodoInput
+	ProjectID *uint
+	Items     []string
+	Tags      []string
+}
+
+// CreateTree creates a todo with its checklist items and tags in one
+// transaction, recording it as a single creation
+func (s *Service) CreateTree(ctx context.Context, userID uint, input CreateTreeInput) (*entity.Todo, error) {
+	todo, err := newTodo(userID, input.CreateTodoInput)
+	if err != nil {
+		return nil, err
+	}
+	items, ok := treeItems(input.Items)
+	if !ok || (input.ProjectID != nil && *input.ProjectID == 0) {
+		return nil, domain.ErrInvalidInput
+	}
+	names, ok := treeTags(input.Tags)
+	if !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var created *entity.Todo
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if input.ProjectID != nil {
+			if err := s.checkProjectEditor(ctx, userID, *input.ProjectID); err != nil {
+				return err
+			}
+			workflow, err := s.workflowOf(ctx, input.ProjectID)
+			if err != nil {
+				return err
+			}
+			todo.ProjectID = input.ProjectID
+			todo.Status = workflow.Initial().Key
+		}
+
+		if err := s.repo.Create(ctx, todo); err != nil {
+			return err
+		}
+
+		for i, title := range items {
+			item := &entity.ChecklistItem{TodoID: todo.ID, Title: title, Position: i}
+			if err := s.items.Create(ctx, item); err != nil {
+				return err
+			}
+		}
+
+		tags, err := s.tagService.FindOrCreate(ctx, userID, names)
+		if err != nil {
+			return err
+		}
+		tagIDs := make([]uint, 0, len(names))
+		for _, name := range names {
+			todo.Tags = append(todo.Tags, tags[name])
+			tagIDs = append(tagIDs, tags[name].ID)
+		}
+		if err := s.repo.AttachTags(ctx, todo.ID, tagIDs); err != nil {
+			return err
+		}
+
+		if err := s.record(ctx, userID, entity.TodoCreated, nil, todo); err != nil {
+			return err
+		}
+
+		created, err = s.repo.FindByID(ctx, userID, todo.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// treeItems trims checklist item titles and checks that there are at most
+// MaxTreeItems of them, each between 1 and 255 characters long
+func treeItems(titles []string) ([]string, bool) {
+	if len(titles) > MaxTreeItems {
+		return nil, false
+	}
+	items := make([]string, 0, len(titles))
+	for _, title := range titles {
+		title = strings.TrimSpace(title)
+		if title == "" || utf8.RuneCountInString(title) > 255 {
+			return nil, false
+		}
+		items = append(items, title)
+	}
+	return items, true
+}
+
+// treeTags normalizes tag names like the tag service does, dropping
+// repeated ones, and checks that there are at most MaxTreeTags of them
+func treeTags(names []string) ([]string, bool) {
+	tags, err := tagNames(names)
+	return tags, err == nil && len(tags) <= MaxTreeTags
+}
@@ -0,0 +1,160 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/contract"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+const (
+	// DefaultBoardColumnSize is the number of todos per board column used
+	// when none is requested
+	DefaultBoardColumnSize = 50
+)
+
+// ErrTransitionNotAllowed is returned when the workflow of a todo does not
+// allow moving it from its status to the requested one
+var ErrTransitionNotAllowed = errors.New("the workflow does not allow this status transition")
+
+// BoardColumn holds the todos in one status of a board, in manual order,
+// together with the total number of todos in that status
+type BoardColumn struct {
+	Status entity.WorkflowStatus
+	Todos  []entity.Todo
+	Total  int64
+}
+
+// Board shows the todos of a project grouped by workflow status, one
+// column per status in workflow order
+type Board struct {
+	Project *entity.Project
+	Columns []BoardColumn
+}
+
+// Board retrieves the board of a project the user is a member of, with up
+// to columnSize todos per column
+func (s *Service) Board(ctx context.Context, userID, projectID uint, columnSize int) (*Board, error) {
+	if userID == 0 || projectID == 0 || columnSize < 0 || columnSize > MaxPageSize {
+		return nil, domain.ErrInvalidInput
+	}
+	if columnSize == 0 {
+		columnSize = DefaultBoardColumnSize
+	}
+
+	project, err := s.projects.FindByID(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := project.Workflow.OrDefault()
+	board := &Board{
+		Project: project,
+		Columns: make([]BoardColumn, 0, len(workflow.Statuses)),
+	}
+	for _, status := range workflow.Statuses {
+		todos, total, err := s.repo.FindAll(ctx, contract.TodoQuery{
+			TodoFilter: contract.TodoFilter{
+				UserID:    userID,
+				ProjectID: &project.ID,
+				Status:    status.Key,
+			},
+			SortBy:    contract.TodoSortPosition,
+			SortOrder: contract.SortAsc,
+			Limit:     columnSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		board.Columns = append(board.Columns, BoardColumn{Status: status, Todos: todos, Total: total})
+	}
+
+	return board, nil
+}
+
+// workflowOf returns the workflow of a project, or the default workflow
+// for the inbox when projectID is nil
+func (s *Service) workflowOf(ctx context.Context, projectID *uint) (entity.Workflow, error) {
+	if projectID == nil {
+		return entity.DefaultWorkflow(), nil
+	}
+	return s.projects.FindWorkflow(ctx, *projectID)
+}
+
+// workflowsOf returns the workflow of each todo keyed by todo ID, loading
+// every project's workflow once
+func (s *Service) workflowsOf(ctx context.Context, todos []entity.Todo) (map[uint]entity.Workflow, error) {
+	byProject := make(map[uint]entity.Workflow)
+	workflows := make(map[uint]entity.Workflow, len(todos))
+	for _, todo := range todos {
+		if todo.ProjectID == nil {
+			workflows[todo.ID] = entity.DefaultWorkflow()
+			continue
+		}
+
+		workflow, ok := byProject[*todo.ProjectID]
+		if !ok {
+			var err error
+			if workflow, err = s.projects.FindWorkflow(ctx, *todo.ProjectID); err != nil {
+				return nil, err
+			}
+			byProject[*todo.ProjectID] = workflow
+		}
+		workflows[todo.ID] = workflow
+	}
+	return workflows, nil
+}
+
+// applyStatus applies the status and completed fields of an update to a
+// todo. A new status must be reachable from the current one in the
+// workflow. The completed flag on its own moves the todo to the first
+// done status, or when reopening the first open status, the workflow
+// allows from its current one.
+func applyStatus(todo *entity.Todo, input UpdateTodoInput, workflow entity.Workflow, now time.Time) error {
+	if input.Status != nil {
+		status, ok := workflow.Status(*input.Status)
+		if !ok {
+			return domain.ErrInvalidInput
+		}
+		if input.Completed != nil && *input.Completed != status.Done {
+			return domain.ErrInvalidInput
+		}
+		if status.Key == todo.Status {
+			return nil
+		}
+		if !workflow.Allows(todo.Status, status.Key) {
+			return ErrTransitionNotAllowed
+		}
+		setStatus(todo, status, now)
+		return nil
+	}
+
+	if input.Completed != nil && *input.Completed != todo.Completed {
+		status, ok := workflow.Next(todo.Status, *input.Completed)
+		if !ok {
+			return ErrTransitionNotAllowed
+		}
+		setStatus(todo, status, now)
+	}
+	return nil
+}
+
+// setStatus puts a todo in a status and derives its completed flag.
+// CompletedAt is stamped when the todo becomes completed and cleared when
+// it is reopened.
+func setStatus(todo *entity.Todo, status entity.WorkflowStatus, now time.Time) {
+	todo.Status = status.Key
+	if status.Done == todo.Completed {
+		return
+	}
+
+	todo.Completed = status.Done
+	if todo.Completed {
+		todo.CompletedAt = &now
+	} else {
+		todo.CompletedAt = nil
+	}
+}
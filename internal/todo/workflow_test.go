@@ -0,0 +1,131 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arulkarim/golden-architecture/internal/domain"
+	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+)
+
+func TestApplyStatus(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	status := func(key string) *string { return &key }
+	completed := func(done bool) *bool { return &done }
+
+	tests := []struct {
+		name            string
+		todo            entity.Todo
+		input           UpdateTodoInput
+		wantStatus      string
+		wantCompleted   bool
+		wantCompletedAt *time.Time
+		wantErr         error
+	}{
+		{
+			name:       "allowed transition",
+			todo:       entity.Todo{Status: entity.StatusBacklog},
+			input:      UpdateTodoInput{Status: status(entity.StatusInProgress)},
+			wantStatus: entity.StatusInProgress,
+		},
+		{
+			name:            "transition to a done status completes",
+			todo:            entity.Todo{Status: entity.StatusReview},
+			input:           UpdateTodoInput{Status: status(entity.StatusDone)},
+			wantStatus:      entity.StatusDone,
+			wantCompleted:   true,
+			wantCompletedAt: &now,
+		},
+		{
+			name:       "transition out of a done status reopens",
+			todo:       entity.Todo{Status: entity.StatusDone, Completed: true, CompletedAt: &earlier},
+			input:      UpdateTodoInput{Status: status(entity.StatusInProgress)},
+			wantStatus: entity.StatusInProgress,
+		},
+		{
+			name:       "same status is a no-op",
+			todo:       entity.Todo{Status: entity.StatusBacklog},
+			input:      UpdateTodoInput{Status: status(entity.StatusBacklog)},
+			wantStatus: entity.StatusBacklog,
+		},
+		{
+			name:    "disallowed transition",
+			todo:    entity.Todo{Status: entity.StatusBacklog},
+			input:   UpdateTodoInput{Status: status(entity.StatusDone)},
+			wantErr: ErrTransitionNotAllowed,
+		},
+		{
+			name:    "unknown status",
+			todo:    entity.Todo{Status: entity.StatusBacklog},
+			input:   UpdateTodoInput{Status: status("blocked")},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "completed contradicts status",
+			todo:    entity.Todo{Status: entity.StatusReview},
+			input:   UpdateTodoInput{Status: status(entity.StatusDone), Completed: completed(false)},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:            "completed on its own moves to the reachable done status",
+			todo:            entity.Todo{Status: entity.StatusReview},
+			input:           UpdateTodoInput{Completed: completed(true)},
+			wantStatus:      entity.StatusDone,
+			wantCompleted:   true,
+			wantCompletedAt: &now,
+		},
+		{
+			name:    "completed on its own without a reachable done status",
+			todo:    entity.Todo{Status: entity.StatusBacklog},
+			input:   UpdateTodoInput{Completed: completed(true)},
+			wantErr: ErrTransitionNotAllowed,
+		},
+		{
+			name:       "reopening on its own moves to the reachable open status",
+			todo:       entity.Todo{Status: entity.StatusDone, Completed: true, CompletedAt: &earlier},
+			input:      UpdateTodoInput{Completed: completed(false)},
+			wantStatus: entity.StatusInProgress,
+		},
+		{
+			name:            "completed matching the todo is a no-op",
+			todo:            entity.Todo{Status: entity.StatusDone, Completed: true, CompletedAt: &earlier},
+			input:           UpdateTodoInput{Completed: completed(true)},
+			wantStatus:      entity.StatusDone,
+			wantCompleted:   true,
+			wantCompletedAt: &earlier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo := tt.todo
+			err := applyStatus(&todo, tt.input, entity.DefaultWorkflow(), now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("applyStatus() error = %v, want %v", err, tt.wantErr)
+				}
+				if todo.Status != tt.todo.Status || todo.Completed != tt.todo.Completed {
+					t.Errorf("applyStatus() changed the todo to %q/%v on error", todo.Status, todo.Completed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyStatus() unexpected error: %v", err)
+			}
+			if todo.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", todo.Status, tt.wantStatus)
+			}
+			if todo.Completed != tt.wantCompleted {
+				t.Errorf("Completed = %v, want %v", todo.Completed, tt.wantCompleted)
+			}
+			switch {
+			case tt.wantCompletedAt == nil && todo.CompletedAt != nil:
+				t.Errorf("CompletedAt = %v, want nil", todo.CompletedAt)
+			case tt.wantCompletedAt != nil && (todo.CompletedAt == nil || !todo.CompletedAt.Equal(*tt.wantCompletedAt)):
+				t.Errorf("CompletedAt = %v, want %v", todo.CompletedAt, *tt.wantCompletedAt)
+			}
+		})
+	}
+}
@@ -93,9 +93,8 @@ func (h *Handler) Login(c *gin.Context) {
 
 // Profile handles GET /api/v1/auth/profile
 func (h *Handler) Profile(c *gin.Context) {
-	userID, ok := auth.GetUserIDFromContext(c)
+	userID, ok := auth.CurrentUserID(c)
 	if !ok {
-		response.Error(c, 401, "Unauthorized", "User not found in context")
 		return
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/arulkarim/golden-architecture/internal/domain"
 	"github.com/arulkarim/golden-architecture/internal/domain/contract"
 	"github.com/arulkarim/golden-architecture/internal/domain/entity"
+	"github.com/arulkarim/golden-architecture/internal/infrastructure/database"
 	"gorm.io/gorm"
 )
 
@@ -22,7 +23,7 @@ func NewUserRepository(db *gorm.DB) contract.UserRepository {
 
 // Create creates a new user
 func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
-	result := r.db.WithContext(ctx).Create(user)
+	result := database.Conn(ctx, r.db).Create(user)
 	if result.Error != nil {
 		// Check for duplicate email
 		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
@@ -33,10 +34,11 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 	return nil
 }
 
-// FindByEmail finds a user by email
+// FindByEmail finds a user by email, ignoring case; idx_users_email_lower
+// serves the lookup
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	result := r.db.WithContext(ctx).Where("email = ?", email).First(&user)
+	result := database.Conn(ctx, r.db).Where("LOWER(email) = LOWER(?)", email).First(&user)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrNotFound
@@ -49,7 +51,7 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity
 // FindByID finds a user by ID
 func (r *userRepository) FindByID(ctx context.Context, id uint) (*entity.User, error) {
 	var user entity.User
-	result := r.db.WithContext(ctx).First(&user, id)
+	result := database.Conn(ctx, r.db).First(&user, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrNotFound
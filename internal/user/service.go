@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/arulkarim/golden-architecture/internal/domain"
 	"github.com/arulkarim/golden-architecture/internal/domain/contract"
@@ -19,15 +20,24 @@ var (
 
 // Service provides user/auth business logic
 type Service struct {
-	repo       contract.UserRepository
-	jwtManager *auth.JWTManager
+	repo        contract.UserRepository
+	invitations contract.ProjectInvitationRepository
+	tx          contract.Transactor
+	jwtManager  *auth.JWTManager
 }
 
 // NewService creates a new user service
-func NewService(repo contract.UserRepository, jwtManager *auth.JWTManager) *Service {
+func NewService(
+	repo contract.UserRepository,
+	invitations contract.ProjectInvitationRepository,
+	tx contract.Transactor,
+	jwtManager *auth.JWTManager,
+) *Service {
 	return &Service{
-		repo:       repo,
-		jwtManager: jwtManager,
+		repo:        repo,
+		invitations: invitations,
+		tx:          tx,
+		jwtManager:  jwtManager,
 	}
 }
 
@@ -49,7 +59,8 @@ type AuthResult struct {
 	User  *entity.User
 }
 
-// Register registers a new user
+// Register registers a new user, who joins the projects their email was
+// invited to
 func (s *Service) Register(ctx context.Context, input RegisterInput) (*AuthResult, error) {
 	// Check if email already exists
 	existingUser, err := s.repo.FindByEmail(ctx, input.Email)
@@ -72,7 +83,13 @@ func (s *Service) Register(ctx context.Context, input RegisterInput) (*AuthResul
 		Password: string(hashedPassword),
 	}
 
-	if err := s.repo.Create(ctx, user); err != nil {
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, user); err != nil {
+			return err
+		}
+		return s.invitations.Accept(ctx, strings.ToLower(strings.TrimSpace(user.Email)), user.ID)
+	})
+	if err != nil {
 		if errors.Is(err, domain.ErrDuplicateEntry) {
 			return nil, ErrEmailAlreadyExists
 		}
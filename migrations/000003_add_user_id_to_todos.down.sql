@@ -0,0 +1,5 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_todos_user_id;
+
+-- Drop owner column
+ALTER TABLE todos DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,26 @@
+-- Add owner column to todos
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+
+-- Todos created before ownership existed were visible to everyone; they
+-- are adopted by the user whose ID is set in app.legacy_todo_owner, e.g.
+-- PGOPTIONS='-c app.legacy_todo_owner=42', or else by the first registered
+-- user. An ID without a user fails the foreign key.
+UPDATE todos
+SET user_id = COALESCE(
+    NULLIF(current_setting('app.legacy_todo_owner', true), '')::integer,
+    (SELECT MIN(id) FROM users)
+)
+WHERE user_id IS NULL;
+
+-- Refuse to continue rather than drop todos nobody can own
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM todos WHERE user_id IS NULL) THEN
+        RAISE EXCEPTION 'todos without an owner remain: register a user to adopt them and run this migration again';
+    END IF;
+END
+$$;
+ALTER TABLE todos ALTER COLUMN user_id SET NOT NULL;
+
+-- Create index for per-user queries
+CREATE INDEX IF NOT EXISTS idx_todos_user_id ON todos(user_id);
@@ -0,0 +1,2 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_todos_user_created_at_id;
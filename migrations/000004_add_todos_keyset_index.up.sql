@@ -0,0 +1,2 @@
+-- Create index matching the keyset ordering used by cursor pagination
+CREATE INDEX IF NOT EXISTS idx_todos_user_created_at_id ON todos(user_id, created_at DESC, id DESC);
@@ -0,0 +1,5 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_todos_search_vector;
+
+-- Drop search column
+ALTER TABLE todos DROP COLUMN IF EXISTS search_vector;
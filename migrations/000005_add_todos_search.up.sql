@@ -0,0 +1,9 @@
+-- Add generated full-text search column; titles weigh more than descriptions
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED;
+
+-- Create GIN index for full-text search
+CREATE INDEX IF NOT EXISTS idx_todos_search_vector ON todos USING GIN(search_vector);
@@ -0,0 +1,7 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_todos_user_due_at;
+
+-- Drop scheduling columns
+ALTER TABLE todos DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE todos DROP COLUMN IF EXISTS due_at;
+ALTER TABLE todos DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,11 @@
+-- Add due date, priority and completion time to todos
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS priority VARCHAR(16) NOT NULL DEFAULT 'medium'
+    CHECK (priority IN ('low', 'medium', 'high', 'urgent'));
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS due_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP WITH TIME ZONE;
+
+-- Best-effort completion time for todos completed before the column existed
+UPDATE todos SET completed_at = updated_at WHERE completed AND completed_at IS NULL;
+
+-- Create index for due date queries on open todos
+CREATE INDEX IF NOT EXISTS idx_todos_user_due_at ON todos(user_id, due_at) WHERE NOT completed;
@@ -0,0 +1,6 @@
+-- Drop checklist_items table
+DROP INDEX IF EXISTS idx_checklist_items_todo_id;
+DROP TABLE IF EXISTS checklist_items;
+
+-- Drop auto-complete flag
+ALTER TABLE todos DROP COLUMN IF EXISTS auto_complete;
@@ -0,0 +1,4 @@
+-- Drop recurrence columns
+ALTER TABLE todos DROP COLUMN IF EXISTS next_occurrence_id;
+ALTER TABLE todos DROP COLUMN IF EXISTS timezone;
+ALTER TABLE todos DROP COLUMN IF EXISTS recurrence;
@@ -0,0 +1,6 @@
+-- Store the recurrence rule of a todo and the timezone it is evaluated in
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS recurrence VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- Link a completed occurrence to the occurrence generated after it
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS next_occurrence_id INTEGER REFERENCES todos(id) ON DELETE SET NULL;
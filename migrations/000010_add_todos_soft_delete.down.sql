@@ -0,0 +1,6 @@
+-- Permanently remove trashed todos before dropping the column
+DELETE FROM todos WHERE deleted_at IS NOT NULL;
+
+-- Drop soft delete column
+DROP INDEX IF EXISTS idx_todos_deleted_at;
+ALTER TABLE todos DROP COLUMN IF EXISTS deleted_at;
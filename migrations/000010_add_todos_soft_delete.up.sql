@@ -0,0 +1,5 @@
+-- Keep deleted todos in the trash until they are purged
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Create index for excluding trashed todos and purging expired ones
+CREATE INDEX IF NOT EXISTS idx_todos_deleted_at ON todos(deleted_at);
@@ -0,0 +1,2 @@
+-- Drop version column
+ALTER TABLE todos DROP COLUMN IF EXISTS version;
@@ -0,0 +1,2 @@
+-- Track a version per todo for optimistic concurrency control
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,5 @@
+-- Drop todo_events table
+DROP TRIGGER IF EXISTS todo_events_immutable ON todo_events;
+DROP FUNCTION IF EXISTS todo_events_immutable();
+DROP INDEX IF EXISTS idx_todo_events_todo_id;
+DROP TABLE IF EXISTS todo_events;
@@ -0,0 +1,26 @@
+-- Create todo_events table; rows outlive their todo so the audit trail survives purges
+CREATE TABLE IF NOT EXISTS todo_events (
+    id SERIAL PRIMARY KEY,
+    todo_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    actor_id INTEGER,
+    action VARCHAR(16) NOT NULL,
+    version INTEGER NOT NULL,
+    changes JSONB NOT NULL,
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for reading a todo's history
+CREATE INDEX IF NOT EXISTS idx_todo_events_todo_id ON todo_events(todo_id);
+
+-- Keep events immutable
+CREATE OR REPLACE FUNCTION todo_events_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'todo_events rows are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER todo_events_immutable
+    BEFORE UPDATE OR DELETE ON todo_events
+    FOR EACH ROW EXECUTE FUNCTION todo_events_immutable();
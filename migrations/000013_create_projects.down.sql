@@ -0,0 +1,7 @@
+-- Drop project_id column
+DROP INDEX IF EXISTS idx_todos_project_id;
+ALTER TABLE todos DROP COLUMN IF EXISTS project_id;
+
+-- Drop projects table
+DROP INDEX IF EXISTS idx_projects_user_name;
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,8 @@
+-- Drop project_invitations table
+DROP INDEX IF EXISTS idx_project_invitations_email;
+DROP INDEX IF EXISTS idx_project_invitations_project_email;
+DROP TABLE IF EXISTS project_invitations;
+
+-- Drop project_members table
+DROP INDEX IF EXISTS idx_project_members_user_id;
+DROP TABLE IF EXISTS project_members;
@@ -0,0 +1,3 @@
+-- Drop position column
+DROP INDEX IF EXISTS idx_todos_position;
+ALTER TABLE todos DROP COLUMN IF EXISTS position;
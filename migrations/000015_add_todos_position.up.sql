@@ -0,0 +1,13 @@
+-- Add manual ordering rank, compared byte-wise (COLLATE "C")
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS position TEXT NOT NULL DEFAULT '';
+
+-- Rank existing todos in creation order. Hex digits are valid rank digits.
+UPDATE todos SET position = ranked.position
+FROM (
+    SELECT id, lpad(to_hex(row_number() OVER (ORDER BY created_at, id)), 8, '0') || 'i' AS position
+    FROM todos
+) AS ranked
+WHERE todos.id = ranked.id AND todos.position = '';
+
+-- Create index for sorting by and looking up neighbouring positions
+CREATE INDEX IF NOT EXISTS idx_todos_position ON todos(position COLLATE "C");
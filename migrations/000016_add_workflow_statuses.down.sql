@@ -0,0 +1,6 @@
+-- Drop status column
+DROP INDEX IF EXISTS idx_todos_project_status;
+ALTER TABLE todos DROP COLUMN IF EXISTS status;
+
+-- Drop workflow column
+ALTER TABLE projects DROP COLUMN IF EXISTS workflow;
@@ -0,0 +1,9 @@
+-- Add project workflows; NULL stands for the default workflow
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS workflow JSONB;
+
+-- Add todo statuses, derived from the completed flag for existing todos
+ALTER TABLE todos ADD COLUMN IF NOT EXISTS status VARCHAR(32) NOT NULL DEFAULT 'backlog';
+UPDATE todos SET status = 'done' WHERE completed;
+
+-- Create index for listing a project's board
+CREATE INDEX IF NOT EXISTS idx_todos_project_status ON todos(project_id, status);
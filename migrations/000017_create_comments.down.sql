@@ -0,0 +1,8 @@
+-- Drop notifications table
+DROP INDEX IF EXISTS idx_notifications_user_id;
+DROP TABLE IF EXISTS notifications;
+
+-- Drop comments table
+DROP INDEX IF EXISTS idx_comments_deleted_at;
+DROP INDEX IF EXISTS idx_comments_todo_id;
+DROP TABLE IF EXISTS comments;
@@ -0,0 +1,4 @@
+-- Drop attachments table
+DROP INDEX IF EXISTS idx_attachments_storage_key;
+DROP INDEX IF EXISTS idx_attachments_todo_id;
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,3 @@
+-- Drop todo_dependencies table
+DROP INDEX IF EXISTS idx_todo_dependencies_blocker_id;
+DROP TABLE IF EXISTS todo_dependencies;
@@ -0,0 +1,3 @@
+-- Drop templates table
+DROP INDEX IF EXISTS idx_templates_user_name;
+DROP TABLE IF EXISTS templates;
@@ -0,0 +1,17 @@
+-- Create templates table
+CREATE TABLE IF NOT EXISTS templates (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    priority VARCHAR(16) NOT NULL DEFAULT 'medium',
+    due_offset VARCHAR(32) NOT NULL DEFAULT '',
+    items JSONB NOT NULL DEFAULT '[]',
+    tags JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create unique index so template names are unique per user
+CREATE UNIQUE INDEX IF NOT EXISTS idx_templates_user_name ON templates(user_id, name);
@@ -0,0 +1,7 @@
+-- Drop the notifications' link to reminders
+ALTER TABLE notifications DROP COLUMN IF EXISTS reminder_id;
+
+-- Drop reminders table
+DROP INDEX IF EXISTS idx_reminders_user_id;
+DROP INDEX IF EXISTS idx_reminders_todo_id;
+DROP TABLE IF EXISTS reminders;
@@ -0,0 +1,24 @@
+-- Create reminders table
+CREATE TABLE IF NOT EXISTS reminders (
+    id SERIAL PRIMARY KEY,
+    todo_id INTEGER NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    remind_at TIMESTAMP WITH TIME ZONE,
+    minutes_before INTEGER,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    retry_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((remind_at IS NULL) <> (minutes_before IS NULL)),
+    CHECK (minutes_before IS NULL OR minutes_before >= 0)
+);
+
+-- Create indexes for listing a todo's reminders and a user's reminders
+CREATE INDEX IF NOT EXISTS idx_reminders_todo_id ON reminders(todo_id);
+CREATE INDEX IF NOT EXISTS idx_reminders_user_id ON reminders(user_id);
+
+-- Link notifications to the reminder they deliver
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS reminder_id INTEGER REFERENCES reminders(id) ON DELETE SET NULL;
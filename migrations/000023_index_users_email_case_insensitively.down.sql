@@ -0,0 +1,3 @@
+-- Restore the case-sensitive lookup index
+DROP INDEX IF EXISTS idx_users_email_lower;
+CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
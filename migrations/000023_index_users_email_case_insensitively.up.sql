@@ -0,0 +1,13 @@
+-- Emails are looked up ignoring case, so two accounts may not differ in
+-- the case of their email alone. Refuse to continue rather than pick one.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM users GROUP BY LOWER(email) HAVING COUNT(*) > 1) THEN
+        RAISE EXCEPTION 'users whose emails differ only in case remain: merge or rename them and run this migration again';
+    END IF;
+END
+$$;
+
+-- Replace the case-sensitive lookup index
+DROP INDEX IF EXISTS idx_users_email;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users(LOWER(email));
@@ -0,0 +1,3 @@
+-- Drop the per-list unique position indexes
+DROP INDEX IF EXISTS idx_todos_project_position;
+DROP INDEX IF EXISTS idx_todos_inbox_position;
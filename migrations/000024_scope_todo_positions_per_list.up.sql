@@ -0,0 +1,26 @@
+-- Todos are ordered per list: the todos of a project, or one owner's inbox
+-- of todos outside any project. Re-rank the lists holding equal positions,
+-- in their current order, so positions can be unique within a list.
+WITH lists AS (
+    SELECT DISTINCT project_id, CASE WHEN project_id IS NULL THEN user_id END AS owner_id
+    FROM todos
+    GROUP BY project_id, CASE WHEN project_id IS NULL THEN user_id END, position
+    HAVING count(*) > 1
+), ranked AS (
+    SELECT todos.id, lpad(to_hex(row_number() OVER (
+        PARTITION BY lists.project_id, lists.owner_id
+        ORDER BY todos.position COLLATE "C", todos.id
+    )), 8, '0') || 'i' AS position
+    FROM todos
+    JOIN lists ON todos.project_id IS NOT DISTINCT FROM lists.project_id
+        AND (CASE WHEN todos.project_id IS NULL THEN todos.user_id END) IS NOT DISTINCT FROM lists.owner_id
+)
+UPDATE todos SET position = ranked.position, version = todos.version + 1
+FROM ranked
+WHERE todos.id = ranked.id;
+
+-- Enforce unique positions within each kind of list
+CREATE UNIQUE INDEX IF NOT EXISTS idx_todos_inbox_position
+    ON todos(user_id, position COLLATE "C") WHERE project_id IS NULL AND position <> '';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_todos_project_position
+    ON todos(project_id, position COLLATE "C") WHERE project_id IS NOT NULL AND position <> '';
@@ -0,0 +1,64 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a cursor is malformed or has been tampered with
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Codec encodes and decodes opaque, signed pagination cursors.
+// A cursor is the base64url-encoded JSON payload followed by its HMAC-SHA256 signature.
+type Codec struct {
+	secret []byte
+}
+
+// NewCodec creates a new cursor codec signing with the given secret
+func NewCodec(secret string) *Codec {
+	return &Codec{secret: []byte(secret)}
+}
+
+// Encode serializes and signs a cursor payload
+func (c *Codec) Encode(payload interface{}) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	body := base64.RawURLEncoding.EncodeToString(data)
+	return body + "." + base64.RawURLEncoding.EncodeToString(c.sign(body)), nil
+}
+
+// Decode verifies a cursor and deserializes its payload into v
+func (c *Codec) Decode(token string, v interface{}) error {
+	body, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidCursor
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, c.sign(body)) {
+		return ErrInvalidCursor
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
+
+// sign computes the HMAC-SHA256 signature of the encoded payload
+func (c *Codec) sign(body string) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(body))
+	return h.Sum(nil)
+}
@@ -0,0 +1,65 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type payload struct {
+	ID        uint   `json:"id"`
+	CreatedAt string `json:"created_at"`
+}
+
+func TestCodec(t *testing.T) {
+	codec := NewCodec("secret")
+	token, err := codec.Encode(payload{ID: 42, CreatedAt: "2025-01-01T00:00:00Z"})
+	if err != nil {
+		t.Fatalf("Encode() unexpected error: %v", err)
+	}
+	body, signature, _ := strings.Cut(token, ".")
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"id":1}`))
+
+	tests := []struct {
+		name    string
+		codec   *Codec
+		token   string
+		want    payload
+		wantErr bool
+	}{
+		{name: "round trip", codec: codec, token: token, want: payload{ID: 42, CreatedAt: "2025-01-01T00:00:00Z"}},
+		{name: "other secret", codec: NewCodec("other"), token: token, wantErr: true},
+		{name: "tampered body", codec: codec, token: forged + "." + signature, wantErr: true},
+		{name: "tampered signature", codec: codec, token: body + "." + base64.RawURLEncoding.EncodeToString([]byte("forged")), wantErr: true},
+		{name: "missing signature", codec: codec, token: body, wantErr: true},
+		{name: "signature not base64", codec: codec, token: body + ".!!!", wantErr: true},
+		{name: "empty", codec: codec, token: "", wantErr: true},
+		{name: "signed payload of the wrong shape", codec: codec, token: signedToken(codec, `"text"`), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got payload
+			err := tt.codec.Decode(tt.token, &got)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCursor) {
+					t.Fatalf("Decode(%q) error = %v, want %v", tt.token, err, ErrInvalidCursor)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode(%q) unexpected error: %v", tt.token, err)
+			}
+			if got != tt.want {
+				t.Errorf("Decode(%q) = %+v, want %+v", tt.token, got, tt.want)
+			}
+		})
+	}
+}
+
+// signedToken signs a raw JSON payload the way Encode does
+func signedToken(codec *Codec, data string) string {
+	body := base64.RawURLEncoding.EncodeToString([]byte(data))
+	return body + "." + base64.RawURLEncoding.EncodeToString(codec.sign(body))
+}
@@ -0,0 +1,159 @@
+// Package ical reads and writes the subset of iCalendar (RFC 5545) used to exchange
+// todos: a VCALENDAR object holding VTODO components.
+package ical
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Todo statuses (RFC 5545, section 3.8.1.11)
+const (
+	StatusNeedsAction = "NEEDS-ACTION"
+	StatusInProcess   = "IN-PROCESS"
+	StatusCompleted   = "COMPLETED"
+	StatusCancelled   = "CANCELLED"
+)
+
+// dateTimeLayout is the UTC DATE-TIME form
+const dateTimeLayout = "20060102T150405Z"
+
+// maxLineOctets is the longest content line before folding, excluding CRLF
+const maxLineOctets = 75
+
+// Todo is a VTODO component. Priority ranges from 1 (highest) to 9
+// (lowest); zero leaves it undefined. Zero times and empty strings are
+// omitted when writing. Timezone is only set when reading: it is the TZID
+// DUE was given in, when that names a known location.
+type Todo struct {
+	UID          string
+	Summary      string
+	Description  string
+	Status       string
+	Priority     int
+	Due          *time.Time
+	Completed    *time.Time
+	Created      time.Time
+	LastModified time.Time
+	Categories   []string
+	RRule        string
+	Timezone     string
+}
+
+// Writer writes a VCALENDAR object with CRLF line endings, folding long
+// content lines. Errors are sticky: once a write fails every later call
+// returns the same error.
+type Writer struct {
+	w      io.Writer
+	prodID string
+	err    error
+}
+
+// NewWriter creates a writer identifying the producer with prodID
+func NewWriter(w io.Writer, prodID string) *Writer {
+	return &Writer{w: w, prodID: prodID}
+}
+
+// Begin opens the calendar
+func (w *Writer) Begin() error {
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", w.prodID)
+	return w.err
+}
+
+// WriteTodo writes one VTODO component stamped with stamp
+func (w *Writer) WriteTodo(todo *Todo, stamp time.Time) error {
+	w.line("BEGIN", "VTODO")
+	w.line("UID", Escape(todo.UID))
+	w.line("DTSTAMP", formatTime(stamp))
+	if !todo.Created.IsZero() {
+		w.line("CREATED", formatTime(todo.Created))
+	}
+	if !todo.LastModified.IsZero() {
+		w.line("LAST-MODIFIED", formatTime(todo.LastModified))
+	}
+	w.line("SUMMARY", Escape(todo.Summary))
+	if todo.Description != "" {
+		w.line("DESCRIPTION", Escape(todo.Description))
+	}
+	if todo.Due != nil {
+		w.line("DUE", formatTime(*todo.Due))
+	}
+	if todo.RRule != "" {
+		w.line("RRULE", todo.RRule)
+	}
+	if todo.Status != "" {
+		w.line("STATUS", todo.Status)
+	}
+	if todo.Completed != nil {
+		w.line("COMPLETED", formatTime(*todo.Completed))
+	}
+	if todo.Priority > 0 {
+		w.line("PRIORITY", strconv.Itoa(todo.Priority))
+	}
+	if len(todo.Categories) > 0 {
+		categories := make([]string, 0, len(todo.Categories))
+		for _, category := range todo.Categories {
+			categories = append(categories, Escape(category))
+		}
+		w.line("CATEGORIES", strings.Join(categories, ","))
+	}
+	w.line("END", "VTODO")
+	return w.err
+}
+
+// End closes the calendar
+func (w *Writer) End() error {
+	w.line("END", "VCALENDAR")
+	return w.err
+}
+
+// line writes a folded content line
+func (w *Writer) line(name, value string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = io.WriteString(w.w, fold(name+":"+value))
+}
+
+// fold splits a content line into lines of at most 75 octets, continued
+// with a leading space, without breaking UTF-8 sequences
+func fold(line string) string {
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines spend one octet on the leading space
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// Escape escapes a TEXT value
+func Escape(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(text)
+}
+
+// formatTime formats a time as a UTC DATE-TIME
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeLayout)
+}
@@ -0,0 +1,151 @@
+package ical
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{name: "short", line: "SUMMARY:Buy milk", want: "SUMMARY:Buy milk\r\n"},
+		{name: "exactly 75 octets", line: strings.Repeat("a", 75), want: strings.Repeat("a", 75) + "\r\n"},
+		{name: "76 octets", line: strings.Repeat("a", 76), want: strings.Repeat("a", 75) + "\r\n a\r\n"},
+		{
+			name: "continuation lines hold 74 octets",
+			line: strings.Repeat("a", 75+74+1),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n",
+		},
+		{
+			name: "multi-byte character is not split",
+			line: strings.Repeat("a", 74) + "é",
+			want: strings.Repeat("a", 74) + "\r\n é\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fold(tt.line)
+			if got != tt.want {
+				t.Errorf("fold() = %q, want %q", got, tt.want)
+			}
+			for _, line := range strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n") {
+				if len(line) > maxLineOctets || !utf8.ValidString(line) {
+					t.Errorf("folded line %q is longer than %d octets or splits a character", line, maxLineOctets)
+				}
+			}
+		})
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plain", text: "Buy milk", want: "Buy milk"},
+		{name: "separators", text: "milk, eggs; bread", want: `milk\, eggs\; bread`},
+		{name: "backslash", text: `C:\temp`, want: `C:\\temp`},
+		{name: "line breaks", text: "a\r\nb\nc\rd", want: `a\nb\nc\nd`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Escape(tt.text)
+			if got != tt.want {
+				t.Errorf("Escape(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+			if want := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(tt.text); Unescape(got) != want {
+				t.Errorf("Unescape(Escape(%q)) = %q, want %q", tt.text, Unescape(got), want)
+			}
+		})
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	due := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	todos := []Todo{
+		{
+			UID:         "todo-1@example.com",
+			Summary:     "Buy milk, eggs; " + strings.Repeat("and more ", 10),
+			Description: "First line\nsecond line",
+			Status:      StatusCompleted,
+			Priority:    1,
+			Due:         &due,
+			Completed:   &due,
+			Created:     due.Add(-time.Hour),
+			Categories:  []string{"home", "a,b"},
+			RRule:       "FREQ=WEEKLY",
+		},
+		{UID: "todo-2@example.com", Summary: "Call"},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, "-//test//EN")
+	if err := w.Begin(); err != nil {
+		t.Fatalf("Begin() unexpected error: %v", err)
+	}
+	for i := range todos {
+		if err := w.WriteTodo(&todos[i], due); err != nil {
+			t.Fatalf("WriteTodo() unexpected error: %v", err)
+		}
+	}
+	if err := w.End(); err != nil {
+		t.Fatalf("End() unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//test//EN\r\n") || !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Fatalf("calendar is not wrapped in VCALENDAR:\n%s", out)
+	}
+
+	r := NewReader(&buf)
+	for i, want := range todos {
+		got, err := r.ReadTodo()
+		if err != nil {
+			t.Fatalf("ReadTodo() %d unexpected error: %v", i, err)
+		}
+		if !sameTime(got.Due, want.Due) || !sameTime(got.Completed, want.Completed) || !got.Created.Equal(want.Created) {
+			t.Errorf("todo %d times = %v/%v/%v, want %v/%v/%v", i, got.Due, got.Completed, got.Created, want.Due, want.Completed, want.Created)
+		}
+		got.Due, got.Completed, got.Created = nil, nil, time.Time{}
+		want.Due, want.Completed, want.Created = nil, nil, time.Time{}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("todo %d = %+v, want %+v", i, *got, want)
+		}
+	}
+	if _, err := r.ReadTodo(); !errors.Is(err, io.EOF) {
+		t.Errorf("ReadTodo() after the last todo error = %v, want io.EOF", err)
+	}
+}
+
+// failingWriter fails every write
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestWriterStickyError(t *testing.T) {
+	w := NewWriter(failingWriter{}, "-//test//EN")
+	first := w.Begin()
+	if first == nil {
+		t.Fatal("Begin() error = nil, want the write error")
+	}
+	if err := w.WriteTodo(&Todo{Summary: "Call"}, time.Now()); err != first {
+		t.Errorf("WriteTodo() error = %v, want %v", err, first)
+	}
+	if err := w.End(); err != first {
+		t.Errorf("End() error = %v, want %v", err, first)
+	}
+}
@@ -0,0 +1,248 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCalendar is returned when a calendar cannot be parsed
+var ErrInvalidCalendar = errors.New("invalid calendar")
+
+// dateLayout is the DATE form
+const dateLayout = "20060102"
+
+// Reader reads VTODO components from a calendar, ignoring every other
+// component and unsupported property
+type Reader struct {
+	scanner *bufio.Scanner
+	pending string
+	ok      bool
+	line    int
+	start   int
+}
+
+// NewReader creates a reader of the calendar in r
+func NewReader(r io.Reader) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	return &Reader{scanner: scanner}
+}
+
+// ReadTodo returns the next VTODO, or io.EOF when there are no more
+func (r *Reader) ReadTodo() (*Todo, error) {
+	var todo *Todo
+	depth := 0
+	for {
+		name, params, value, err := r.next()
+		if err == io.EOF && todo != nil {
+			return nil, r.invalid("unterminated VTODO")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case todo == nil:
+			if name == "BEGIN" && strings.EqualFold(value, "VTODO") {
+				todo = &Todo{}
+			}
+		case name == "BEGIN":
+			// Nested components such as VALARM are skipped
+			depth++
+		case name == "END" && depth > 0:
+			depth--
+		case name == "END":
+			return todo, nil
+		case depth == 0:
+			if err := todo.set(name, params, value); err != nil {
+				return nil, r.invalid("%s: %v", name, err)
+			}
+		}
+	}
+}
+
+// set stores a property of the todo
+func (t *Todo) set(name string, params map[string]string, value string) error {
+	var err error
+	switch name {
+	case "UID":
+		t.UID = Unescape(value)
+	case "SUMMARY":
+		t.Summary = Unescape(value)
+	case "DESCRIPTION":
+		t.Description = Unescape(value)
+	case "STATUS":
+		t.Status = strings.ToUpper(value)
+	case "PRIORITY":
+		t.Priority, err = strconv.Atoi(value)
+		if err == nil && (t.Priority < 0 || t.Priority > 9) {
+			err = errors.New("out of range")
+		}
+	case "DUE":
+		var due time.Time
+		due, t.Timezone, err = parseTime(params, value)
+		t.Due = &due
+	case "COMPLETED":
+		var completed time.Time
+		completed, _, err = parseTime(params, value)
+		t.Completed = &completed
+	case "CREATED":
+		t.Created, _, err = parseTime(params, value)
+	case "LAST-MODIFIED":
+		t.LastModified, _, err = parseTime(params, value)
+	case "CATEGORIES":
+		for _, category := range splitText(value) {
+			if category = strings.TrimSpace(category); category != "" {
+				t.Categories = append(t.Categories, category)
+			}
+		}
+	case "RRULE":
+		t.RRule = value
+	}
+	return err
+}
+
+// next returns the next unfolded content line split into its name,
+// parameters and value
+func (r *Reader) next() (string, map[string]string, string, error) {
+	for {
+		line, err := r.unfolded()
+		if err != nil {
+			return "", nil, "", err
+		}
+		if line == "" {
+			continue
+		}
+
+		head, value, ok := cutUnquoted(line, ':')
+		if !ok {
+			return "", nil, "", r.invalid("missing value")
+		}
+		parts := splitUnquoted(head, ';')
+		params := make(map[string]string, len(parts)-1)
+		for _, param := range parts[1:] {
+			key, val, _ := strings.Cut(param, "=")
+			params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+		}
+		return strings.ToUpper(parts[0]), params, value, nil
+	}
+}
+
+// unfolded reads one logical line, joining continuation lines
+func (r *Reader) unfolded() (string, error) {
+	if !r.ok && !r.scan() {
+		if err := r.scanner.Err(); err != nil {
+			return "", fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+		}
+		return "", io.EOF
+	}
+
+	line := r.pending
+	r.start = r.line
+	for r.scan() {
+		if !strings.HasPrefix(r.pending, " ") && !strings.HasPrefix(r.pending, "\t") {
+			return line, nil
+		}
+		line += r.pending[1:]
+	}
+	return line, nil
+}
+
+// scan reads the next physical line into pending
+func (r *Reader) scan() bool {
+	r.ok = r.scanner.Scan()
+	if r.ok {
+		r.line++
+		r.pending = strings.TrimSuffix(r.scanner.Text(), "\r")
+	}
+	return r.ok
+}
+
+// invalid wraps ErrInvalidCalendar with the number of the current line
+func (r *Reader) invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: line %d: %s", ErrInvalidCalendar, r.start, fmt.Sprintf(format, args...))
+}
+
+// parseTime parses a DATE-TIME or DATE value. Local times are read in their
+// TZID location when it is known and in UTC otherwise; the location name is
+// returned when it was used.
+func parseTime(params map[string]string, value string) (time.Time, string, error) {
+	loc, tzid := time.UTC, ""
+	if name := params["TZID"]; name != "" {
+		if l, err := time.LoadLocation(name); err == nil {
+			loc, tzid = l, name
+		}
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(dateTimeLayout, value)
+		return t, tzid, err
+	}
+	layout := dateTimeLayout[:len(dateTimeLayout)-1]
+	if params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		layout = dateLayout
+	}
+	t, err := time.ParseInLocation(layout, value, loc)
+	return t, tzid, err
+}
+
+// Unescape unescapes a TEXT value
+func Unescape(text string) string {
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	).Replace(text)
+}
+
+// splitText splits a list of TEXT values on unescaped commas and unescapes them
+func splitText(value string) []string {
+	var items []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			items = append(items, Unescape(value[start:i]))
+			start = i + 1
+		}
+	}
+	return append(items, Unescape(value[start:]))
+}
+
+// cutUnquoted slices s around the first sep outside double quotes
+func cutUnquoted(s string, sep byte) (before, after string, found bool) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				return s[:i], s[i+1:], true
+			}
+		}
+	}
+	return s, "", false
+}
+
+// splitUnquoted splits s on every sep outside double quotes
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	for {
+		before, after, found := cutUnquoted(s, sep)
+		parts = append(parts, before)
+		if !found {
+			return parts
+		}
+		s = after
+	}
+}
@@ -0,0 +1,225 @@
+package ical
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// calendar joins content lines with CRLF inside a VCALENDAR object
+func calendar(lines ...string) string {
+	all := append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...)
+	all = append(all, "END:VCALENDAR")
+	return strings.Join(all, "\r\n") + "\r\n"
+}
+
+func TestReaderReadTodo(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+	due := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	localDue := time.Date(2026, 3, 1, 9, 30, 0, 0, newYork)
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []Todo
+		wantErr bool
+	}{
+		{
+			name: "properties",
+			input: calendar(
+				"BEGIN:VTODO",
+				"UID:1@example.com",
+				"SUMMARY:Buy milk",
+				"STATUS:completed",
+				"PRIORITY:1",
+				"DUE:20260301T093000Z",
+				"COMPLETED:20260301T093000Z",
+				"CATEGORIES:home,errands",
+				"RRULE:FREQ=WEEKLY",
+				"END:VTODO",
+			),
+			want: []Todo{{
+				UID:        "1@example.com",
+				Summary:    "Buy milk",
+				Status:     StatusCompleted,
+				Priority:   1,
+				Due:        &due,
+				Completed:  &due,
+				Categories: []string{"home", "errands"},
+				RRule:      "FREQ=WEEKLY",
+			}},
+		},
+		{
+			name: "folded lines",
+			input: calendar(
+				"BEGIN:VTODO",
+				"SUMMARY:A long",
+				"  summary folded",
+				"\tover three lines",
+				"END:VTODO",
+			),
+			want: []Todo{{Summary: "A long summary foldedover three lines"}},
+		},
+		{
+			name: "escaped text",
+			input: calendar(
+				"BEGIN:VTODO",
+				`SUMMARY:Milk\, eggs\; bread`,
+				`DESCRIPTION:First line\nsecond line\\`,
+				`CATEGORIES:a\,b,c`,
+				"END:VTODO",
+			),
+			want: []Todo{{
+				Summary:     "Milk, eggs; bread",
+				Description: "First line\nsecond line\\",
+				Categories:  []string{"a,b", "c"},
+			}},
+		},
+		{
+			name: "local time in a known TZID",
+			input: calendar(
+				"BEGIN:VTODO",
+				"SUMMARY:Call",
+				`DUE;TZID="America/New_York":20260301T093000`,
+				"END:VTODO",
+			),
+			want: []Todo{{Summary: "Call", Due: &localDue, Timezone: "America/New_York"}},
+		},
+		{
+			name: "local time in an unknown TZID is read in UTC",
+			input: calendar(
+				"BEGIN:VTODO",
+				"SUMMARY:Call",
+				"DUE;TZID=Mars/Olympus:20260301T093000",
+				"END:VTODO",
+			),
+			want: []Todo{{Summary: "Call", Due: &due}},
+		},
+		{
+			name: "date value",
+			input: calendar(
+				"BEGIN:VTODO",
+				"SUMMARY:Pay rent",
+				"DUE;VALUE=DATE:20260301",
+				"END:VTODO",
+			),
+			want: []Todo{{Summary: "Pay rent", Due: &day}},
+		},
+		{
+			name: "nested components and other components are skipped",
+			input: calendar(
+				"BEGIN:VEVENT",
+				"SUMMARY:Meeting",
+				"END:VEVENT",
+				"BEGIN:VTODO",
+				"SUMMARY:Outer",
+				"BEGIN:VALARM",
+				"SUMMARY:Alarm",
+				"END:VALARM",
+				"END:VTODO",
+				"BEGIN:VTODO",
+				"SUMMARY:Second",
+				"END:VTODO",
+			),
+			want: []Todo{{Summary: "Outer"}, {Summary: "Second"}},
+		},
+		{
+			name:  "empty calendar",
+			input: calendar(),
+		},
+		{
+			name: "bad date",
+			input: calendar(
+				"BEGIN:VTODO",
+				"DUE:2026-03-01",
+				"END:VTODO",
+			),
+			wantErr: true,
+		},
+		{
+			name: "priority out of range",
+			input: calendar(
+				"BEGIN:VTODO",
+				"PRIORITY:10",
+				"END:VTODO",
+			),
+			wantErr: true,
+		},
+		{
+			name: "line without a value",
+			input: calendar(
+				"BEGIN:VTODO",
+				"SUMMARY",
+				"END:VTODO",
+			),
+			wantErr: true,
+		},
+		{
+			name:    "unterminated todo",
+			input:   "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nSUMMARY:Open\r\n",
+			wantErr: true,
+		},
+		{
+			name:    "oversized line",
+			input:   calendar("BEGIN:VTODO", "SUMMARY:"+strings.Repeat("a", 1024*1024), "END:VTODO"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewReader(strings.NewReader(tt.input))
+
+			var got []Todo
+			for {
+				todo, err := reader.ReadTodo()
+				if err == io.EOF {
+					break
+				}
+				if tt.wantErr {
+					if !errors.Is(err, ErrInvalidCalendar) {
+						t.Fatalf("ReadTodo() error = %v, want ErrInvalidCalendar", err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("ReadTodo() unexpected error: %v", err)
+				}
+				got = append(got, *todo)
+			}
+			if tt.wantErr {
+				t.Fatal("ReadTodo() error = nil, want ErrInvalidCalendar")
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("read %d todos, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !sameTime(got[i].Due, tt.want[i].Due) || !sameTime(got[i].Completed, tt.want[i].Completed) {
+					t.Errorf("todo %d times = %v/%v, want %v/%v", i, got[i].Due, got[i].Completed, tt.want[i].Due, tt.want[i].Completed)
+				}
+				got[i].Due, got[i].Completed = nil, nil
+				want := tt.want[i]
+				want.Due, want.Completed = nil, nil
+				if !reflect.DeepEqual(got[i], want) {
+					t.Errorf("todo %d = %+v, want %+v", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+// sameTime reports whether two optional times are both unset or the same instant
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
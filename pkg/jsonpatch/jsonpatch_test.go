@@ -0,0 +1,132 @@
+package jsonpatch
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		patch   string
+		want    string
+		wantErr error
+	}{
+		{name: "replace member", doc: `{"a":"b"}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{name: "add member", doc: `{"a":"b"}`, patch: `{"b":"c"}`, want: `{"a":"b","b":"c"}`},
+		{name: "null removes member", doc: `{"a":"b","b":"c"}`, patch: `{"a":null}`, want: `{"b":"c"}`},
+		{name: "nested objects merge", doc: `{"a":{"b":1,"c":2}}`, patch: `{"a":{"c":null,"d":3}}`, want: `{"a":{"b":1,"d":3}}`},
+		{name: "arrays are replaced", doc: `{"a":[1,2]}`, patch: `{"a":[3]}`, want: `{"a":[3]}`},
+		{name: "object replaces scalar", doc: `{"a":"b"}`, patch: `{"a":{"c":null}}`, want: `{"a":{}}`},
+		{name: "non-object patch replaces document", doc: `{"a":"b"}`, patch: `["c"]`, want: `["c"]`},
+		{name: "large numbers stay exact", doc: `{"a":1}`, patch: `{"a":12345678901234567890}`, want: `{"a":12345678901234567890}`},
+		{name: "malformed patch", doc: `{}`, patch: `{`, wantErr: ErrInvalidPatch},
+		{name: "trailing data", doc: `{} {}`, patch: `{}`, wantErr: ErrInvalidPatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("MergePatch() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergePatch() unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MergePatch() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodePatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		patch   string
+		wantErr bool
+	}{
+		{name: "every op", patch: `[{"op":"add","path":"/a","value":1},{"op":"remove","path":"/a"},{"op":"replace","path":"","value":{}},{"op":"move","from":"/a","path":"/b"},{"op":"copy","from":"/a","path":"/b"},{"op":"test","path":"/a","value":null}]`},
+		{name: "not an array", patch: `{"op":"add"}`, wantErr: true},
+		{name: "unknown op", patch: `[{"op":"merge","path":"/a"}]`, wantErr: true},
+		{name: "missing value", patch: `[{"op":"add","path":"/a"}]`, wantErr: true},
+		{name: "relative path", patch: `[{"op":"remove","path":"a"}]`, wantErr: true},
+		{name: "relative from", patch: `[{"op":"copy","from":"a","path":"/b"}]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodePatch([]byte(tt.patch))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPatch) {
+					t.Fatalf("DecodePatch() error = %v, want %v", err, ErrInvalidPatch)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodePatch() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		patch   string
+		want    string
+		wantErr error
+	}{
+		{name: "add member", doc: `{"a":1}`, patch: `[{"op":"add","path":"/b","value":2}]`, want: `{"a":1,"b":2}`},
+		{name: "add inserts into array", doc: `{"a":[1,3]}`, patch: `[{"op":"add","path":"/a/1","value":2}]`, want: `{"a":[1,2,3]}`},
+		{name: "add appends to array", doc: `{"a":[1]}`, patch: `[{"op":"add","path":"/a/-","value":2}]`, want: `{"a":[1,2]}`},
+		{name: "add escaped member", doc: `{}`, patch: `[{"op":"add","path":"/a~1b~0c","value":1}]`, want: `{"a/b~c":1}`},
+		{name: "remove array element", doc: `{"a":[1,2,3]}`, patch: `[{"op":"remove","path":"/a/1"}]`, want: `{"a":[1,3]}`},
+		{name: "replace member", doc: `{"a":1}`, patch: `[{"op":"replace","path":"/a","value":"x"}]`, want: `{"a":"x"}`},
+		{name: "replace document", doc: `{"a":1}`, patch: `[{"op":"replace","path":"","value":[1]}]`, want: `[1]`},
+		{name: "move member", doc: `{"a":{"b":1}}`, patch: `[{"op":"move","from":"/a/b","path":"/c"}]`, want: `{"a":{},"c":1}`},
+		{name: "copy is deep", doc: `{"a":{"b":1}}`, patch: `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`, want: `{"a":{"b":1},"c":{"b":2}}`},
+		{name: "test compares numbers by value", doc: `{"a":1.0}`, patch: `[{"op":"test","path":"/a","value":1}]`, want: `{"a":1.0}`},
+		{name: "test compares objects regardless of order", doc: `{"a":{"b":1,"c":2}}`, patch: `[{"op":"test","path":"/a","value":{"c":2,"b":1}}]`, want: `{"a":{"b":1,"c":2}}`},
+		{name: "failed test", doc: `{"a":1}`, patch: `[{"op":"test","path":"/a","value":2}]`, wantErr: ErrTestFailed},
+		{name: "add below missing member", doc: `{}`, patch: `[{"op":"add","path":"/a/b","value":1}]`, wantErr: ErrPathNotFound},
+		{name: "remove missing member", doc: `{}`, patch: `[{"op":"remove","path":"/a"}]`, wantErr: ErrPathNotFound},
+		{name: "replace missing member", doc: `{}`, patch: `[{"op":"replace","path":"/a","value":1}]`, wantErr: ErrPathNotFound},
+		{name: "index out of range", doc: `{"a":[1]}`, patch: `[{"op":"add","path":"/a/2","value":1}]`, wantErr: ErrPathNotFound},
+		{name: "index with leading zero", doc: `{"a":[1,2]}`, patch: `[{"op":"remove","path":"/a/01"}]`, wantErr: ErrPathNotFound},
+		{name: "remove document", doc: `{}`, patch: `[{"op":"remove","path":""}]`, wantErr: ErrInvalidPatch},
+		{name: "move into itself", doc: `{"a":{}}`, patch: `[{"op":"move","from":"/a","path":"/a/b"}]`, wantErr: ErrInvalidPatch},
+		{name: "later failure discards earlier operations", doc: `{"a":1}`, patch: `[{"op":"remove","path":"/a"},{"op":"test","path":"/a","value":1}]`, wantErr: ErrPathNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := DecodePatch([]byte(tt.patch))
+			if err != nil {
+				t.Fatalf("DecodePatch() unexpected error: %v", err)
+			}
+
+			got, err := patch.Apply([]byte(tt.doc))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Apply() error = %v, want %v", err, tt.wantErr)
+				}
+				if got != nil {
+					t.Errorf("Apply() = %s, want no document", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Apply() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
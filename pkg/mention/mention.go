@@ -0,0 +1,39 @@
+// Package mention finds @email mentions in markdown text.
+//
+// A mention is an '@' directly followed by an email address, as in
+// "thanks @jane@example.com!". The '@' must start the text or follow a
+// character that cannot be part of an address, so plain addresses such as
+// "jane@example.com" are not mentions.
+package mention
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxMentions is the largest number of distinct mentions read from one text
+const MaxMentions = 20
+
+// pattern matches a mention, capturing the character before it and the address
+var pattern = regexp.MustCompile(`(^|[^A-Za-z0-9._%+\-@])@([A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,})`)
+
+// Emails returns the distinct email addresses mentioned in text in order of
+// first appearance, at most MaxMentions of them. Addresses are compared
+// case-insensitively and returned as first written.
+func Emails(text string) []string {
+	var emails []string
+	seen := make(map[string]bool)
+	for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+		email := match[2]
+		key := strings.ToLower(email)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		emails = append(emails, email)
+		if len(emails) == MaxMentions {
+			break
+		}
+	}
+	return emails
+}
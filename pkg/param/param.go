@@ -0,0 +1,22 @@
+// Package param parses the path parameters of API requests, answering
+// malformed ones with 400 Bad Request the same way on every endpoint.
+package param
+
+import (
+	"strconv"
+
+	"github.com/arulkarim/golden-architecture/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ID parses the positive integer ID in the path parameter name. An invalid
+// ID is answered with 400 Bad Request naming the label, such as "todo",
+// and reported as not ok.
+func ID(c *gin.Context, name, label string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		response.BadRequest(c, "Invalid "+label+" ID", "ID must be a positive integer")
+		return 0, false
+	}
+	return uint(id), true
+}
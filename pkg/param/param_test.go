@@ -0,0 +1,43 @@
+package param
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   uint
+		wantOK bool
+	}{
+		{name: "valid", value: "42", want: 42, wantOK: true},
+		{name: "zero", value: "0"},
+		{name: "negative", value: "-1"},
+		{name: "not a number", value: "abc"},
+		{name: "empty", value: ""},
+		{name: "too large", value: "4294967296"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Params = gin.Params{{Key: "id", Value: tt.value}}
+
+			got, ok := ID(c, "id", "todo")
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("ID(%q) = %d, %v, want %d, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+			if !ok && w.Code != http.StatusBadRequest {
+				t.Errorf("ID(%q) responded with %d, want %d", tt.value, w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
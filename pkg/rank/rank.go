@@ -0,0 +1,199 @@
+// Package rank generates lexicographic ranks for manually ordered lists.
+//
+// A rank is a non-empty string of the digits 0-9a-z that does not end in
+// '0'. Ranks compare byte-wise (COLLATE "C" in PostgreSQL), and there is
+// always room for another rank between any two of them, so moving an item
+// only rewrites the item itself. Repeated inserts into the same gap make
+// ranks longer; Spread reassigns short, evenly spaced ranks.
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	base   = len(digits)
+
+	// minWidth is the length of the first rank and of spread ranks, leaving
+	// room for millions of appends before a rank grows
+	minWidth = 6
+)
+
+// ErrInvalidRange is returned when a bound is not a rank or the bounds are
+// out of order
+var ErrInvalidRange = errors.New("invalid rank range")
+
+// Valid reports whether s is a well-formed rank
+func Valid(s string) bool {
+	if s == "" || s[len(s)-1] == '0' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if digit(s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Between returns a rank sorting strictly between prev and next. An empty
+// bound is open: Between(prev, "") sorts after prev, Between("", next)
+// before next and Between("", "") returns the first rank of an empty list.
+func Between(prev, next string) (string, error) {
+	if (prev != "" && !Valid(prev)) || (next != "" && !Valid(next)) {
+		return "", ErrInvalidRange
+	}
+	if prev != "" && next != "" && prev >= next {
+		return "", ErrInvalidRange
+	}
+
+	switch {
+	case prev == "" && next == "":
+		return strings.Repeat(string(digits[base/2]), minWidth), nil
+	case next == "":
+		return after(prev), nil
+	case prev == "":
+		return before(next), nil
+	}
+	return midpoint(prev, next), nil
+}
+
+// Spread returns n evenly spaced ranks in ascending order, all of the same
+// length and with wide gaps between them
+func Spread(n int) []string {
+	if n <= 0 {
+		return []string{}
+	}
+	ranks := make([]string, 0, n)
+
+	// Widen the ranks until every gap can take a few thousand inserts
+	width := minWidth
+	space := pow(base, width)
+	for space/uint64(n+1) < uint64(base*base) {
+		width++
+		space *= uint64(base)
+	}
+
+	step := space / uint64(n+1)
+	for i := 1; i <= n; i++ {
+		value := uint64(i) * step
+		if value%uint64(base) == 0 {
+			value++
+		}
+		ranks = append(ranks, encode(value, width))
+	}
+	return ranks
+}
+
+// after returns a rank greater than a and no longer than it when possible,
+// by adding one to its last digit and dropping trailing zeros
+func after(a string) string {
+	b := []byte(a)
+	for i := len(b) - 1; i >= 0; i-- {
+		d := digit(b[i])
+		if d < base-1 {
+			b[i] = digits[d+1]
+			return strings.TrimRight(string(b), digits[:1])
+		}
+		b[i] = digits[0]
+	}
+	// a is all z's: extend it instead
+	return a + string(digits[base/2])
+}
+
+// before returns a rank smaller than b of the same length when possible,
+// by subtracting one from its last digit and skipping trailing zeros
+func before(b string) string {
+	c := []byte(b)
+	for {
+		i := len(c) - 1
+		for i >= 0 && c[i] == digits[0] {
+			c[i] = digits[base-1]
+			i--
+		}
+		if i < 0 {
+			// b is the smallest rank of its length: extend below it
+			return strings.Repeat(string(digits[0]), len(b)) + string(digits[base/2])
+		}
+		c[i] = digits[digit(c[i])-1]
+		if c[len(c)-1] != digits[0] {
+			return string(c)
+		}
+	}
+}
+
+// midpoint returns a rank between a and b, where a < b. An empty a stands
+// for zero and an empty b for one past the largest rank.
+func midpoint(a, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && digitAt(a, n) == digit(b[n]) {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	da := digitAt(a, 0)
+	db := base
+	if b != "" {
+		db = digit(b[0])
+	}
+	if db-da > 1 {
+		return string(digits[(da+db)/2])
+	}
+	// The first digits are consecutive
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[da]) + midpoint(suffix(a, 1), "")
+}
+
+// encode formats value in base 36, zero-padded to width digits
+func encode(value uint64, width int) string {
+	b := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		b[i] = digits[value%uint64(base)]
+		value /= uint64(base)
+	}
+	return string(b)
+}
+
+// pow returns x raised to the power of n
+func pow(x, n int) uint64 {
+	result := uint64(1)
+	for i := 0; i < n; i++ {
+		result *= uint64(x)
+	}
+	return result
+}
+
+// digit returns the value of a rank digit, or -1 for any other byte
+func digit(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 10
+	}
+	return -1
+}
+
+// digitAt returns the digit of s at index i, treating missing digits as zero
+func digitAt(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	return digit(s[i])
+}
+
+// suffix returns s from index i, or an empty string when s is shorter
+func suffix(s string, i int) string {
+	if i >= len(s) {
+		return ""
+	}
+	return s[i:]
+}
@@ -0,0 +1,141 @@
+package rank
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "digits and letters", input: "a1z", want: true},
+		{name: "single digit", input: "i", want: true},
+		{name: "empty", input: ""},
+		{name: "trailing zero", input: "a0"},
+		{name: "upper case", input: "A"},
+		{name: "punctuation", input: "a-b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Valid(tt.input); got != tt.want {
+				t.Errorf("Valid(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		prev    string
+		next    string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty list", want: "iiiiii"},
+		{name: "after", prev: "iiiiii", want: "iiiiij"},
+		{name: "after carries", prev: "iz", want: "j"},
+		{name: "after the largest rank", prev: "zz", want: "zzi"},
+		{name: "before", next: "iiiiii", want: "iiiiih"},
+		{name: "before the smallest rank", next: "1", want: "0i"},
+		{name: "midpoint", prev: "a", next: "c", want: "b"},
+		{name: "consecutive digits", prev: "a", next: "b", want: "ai"},
+		{name: "common prefix", prev: "ab1", next: "ab3", want: "ab2"},
+		{name: "equal bounds", prev: "a", next: "a", wantErr: true},
+		{name: "bounds out of order", prev: "b", next: "a", wantErr: true},
+		{name: "invalid prev", prev: "a0", wantErr: true},
+		{name: "invalid next", next: "A", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.prev, tt.next)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRange) {
+					t.Fatalf("Between(%q, %q) error = %v, want %v", tt.prev, tt.next, err, ErrInvalidRange)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Between(%q, %q) unexpected error: %v", tt.prev, tt.next, err)
+			}
+			if got != tt.want {
+				t.Errorf("Between(%q, %q) = %q, want %q", tt.prev, tt.next, got, tt.want)
+			}
+			if !Valid(got) || (tt.prev != "" && got <= tt.prev) || (tt.next != "" && got >= tt.next) {
+				t.Errorf("Between(%q, %q) = %q is not a rank strictly between them", tt.prev, tt.next, got)
+			}
+		})
+	}
+}
+
+func TestBetweenRepeatedInserts(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		next string
+		// lower keeps inserting right after prev instead of right before next
+		lower bool
+	}{
+		{name: "towards next", prev: "a", next: "b"},
+		{name: "towards prev", prev: "a", next: "b", lower: true},
+		{name: "at the end", prev: "z"},
+		{name: "at the start", next: "1", lower: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, next := tt.prev, tt.next
+			for i := 0; i < 200; i++ {
+				got, err := Between(prev, next)
+				if err != nil {
+					t.Fatalf("insert %d: Between(%q, %q) unexpected error: %v", i, prev, next, err)
+				}
+				if !Valid(got) || (prev != "" && got <= prev) || (next != "" && got >= next) {
+					t.Fatalf("insert %d: Between(%q, %q) = %q is not strictly between them", i, prev, next, got)
+				}
+				if tt.lower {
+					next = got
+				} else {
+					prev = got
+				}
+			}
+		})
+	}
+}
+
+func TestSpread(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		wantWidth int
+	}{
+		{name: "none", n: 0},
+		{name: "negative", n: -1},
+		{name: "one", n: 1, wantWidth: minWidth},
+		{name: "a few", n: 10, wantWidth: minWidth},
+		{name: "many", n: 100000, wantWidth: minWidth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Spread(tt.n)
+			if len(got) != max(tt.n, 0) {
+				t.Fatalf("Spread(%d) returned %d ranks", tt.n, len(got))
+			}
+			for i, r := range got {
+				if !Valid(r) || len(r) != tt.wantWidth {
+					t.Fatalf("Spread(%d)[%d] = %q, want a valid rank of length %d", tt.n, i, r, tt.wantWidth)
+				}
+				if i > 0 && strings.Compare(got[i-1], r) >= 0 {
+					t.Fatalf("Spread(%d) is not ascending at %d: %q >= %q", tt.n, i, got[i-1], r)
+				}
+			}
+		})
+	}
+}
@@ -47,11 +47,41 @@ func BadRequest(c *gin.Context, message string, err string) {
 	Error(c, http.StatusBadRequest, message, err)
 }
 
+// Forbidden sends a 403 Forbidden response
+func Forbidden(c *gin.Context, message string, err string) {
+	Error(c, http.StatusForbidden, message, err)
+}
+
 // NotFound sends a 404 Not Found response
 func NotFound(c *gin.Context, message string) {
 	Error(c, http.StatusNotFound, message, "resource not found")
 }
 
+// Conflict sends a 409 Conflict response
+func Conflict(c *gin.Context, message string, err string) {
+	Error(c, http.StatusConflict, message, err)
+}
+
+// UnsupportedMediaType sends a 415 Unsupported Media Type response
+func UnsupportedMediaType(c *gin.Context, message string, err string) {
+	Error(c, http.StatusUnsupportedMediaType, message, err)
+}
+
+// UnprocessableEntity sends a 422 Unprocessable Entity response
+func UnprocessableEntity(c *gin.Context, message string, err string) {
+	Error(c, http.StatusUnprocessableEntity, message, err)
+}
+
+// PreconditionFailed sends a 412 Precondition Failed response
+func PreconditionFailed(c *gin.Context, message string, err string) {
+	Error(c, http.StatusPreconditionFailed, message, err)
+}
+
+// PreconditionRequired sends a 428 Precondition Required response
+func PreconditionRequired(c *gin.Context, message string, err string) {
+	Error(c, http.StatusPreconditionRequired, message, err)
+}
+
 // InternalServerError sends a 500 Internal Server Error response
 func InternalServerError(c *gin.Context, message string, err string) {
 	Error(c, http.StatusInternalServerError, message, err)
@@ -61,3 +91,8 @@ func InternalServerError(c *gin.Context, message string, err string) {
 func NoContent(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
+
+// NotModified sends a 304 Not Modified response
+func NotModified(c *gin.Context) {
+	c.Status(http.StatusNotModified)
+}
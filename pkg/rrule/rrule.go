@@ -0,0 +1,373 @@
+// Package rrule implements the subset of iCalendar recurrence rules
+// (RFC 5545, section 3.3.10) used by recurring todos: FREQ, INTERVAL,
+// BYDAY, BYMONTHDAY, COUNT and UNTIL. Weeks start on Monday.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRule is returned when a recurrence rule cannot be parsed
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// Frequency is the base interval a rule repeats on
+type Frequency string
+
+// Supported frequencies
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// maxPeriods bounds how many periods are scanned for the next occurrence,
+// so rules that can never match (such as BYDAY=5MO;BYMONTHDAY=1) terminate
+const maxPeriods = 10000
+
+// untilLayouts are the accepted UNTIL forms: a UTC date-time or a date
+var untilLayouts = []string{"20060102T150405Z", "20060102"}
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Weekday is a BYDAY entry. N selects the Nth such weekday of the month
+// or year (negative counts from the end); zero selects every one.
+type Weekday struct {
+	Day time.Weekday
+	N   int
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []Weekday
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+// Parse parses an RRULE value such as "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+// A leading "RRULE:" is accepted.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, ErrInvalidRule
+	}
+
+	rule := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		name, val, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		val = strings.ToUpper(strings.TrimSpace(val))
+		if !ok || val == "" || seen[name] {
+			return nil, invalid("malformed part %q", part)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq = Frequency(val)
+			switch rule.Freq {
+			case Daily, Weekly, Monthly, Yearly:
+			default:
+				err = invalid("unsupported FREQ %q", val)
+			}
+		case "INTERVAL":
+			rule.Interval, err = positiveInt(name, val)
+		case "COUNT":
+			rule.Count, err = positiveInt(name, val)
+		case "UNTIL":
+			rule.Until, err = parseUntil(val)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseByMonthDay(val)
+		default:
+			err = invalid("unsupported part %q", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// validate checks the combination of parts
+func (r *Rule) validate() error {
+	if r.Freq == "" {
+		return invalid("FREQ is required")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return invalid("COUNT and UNTIL are mutually exclusive")
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return invalid("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+	if r.Freq == Daily || r.Freq == Weekly {
+		for _, wd := range r.ByDay {
+			if wd.N != 0 {
+				return invalid("numbered BYDAY is only allowed with FREQ=MONTHLY or FREQ=YEARLY")
+			}
+		}
+	}
+	return nil
+}
+
+// String formats the rule in its canonical RRULE form, without the prefix
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			days = append(days, wd.String())
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, day := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(day))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayouts[0]))
+	}
+	return strings.Join(parts, ";")
+}
+
+// String formats the weekday as a BYDAY entry such as "MO" or "-1FR"
+func (wd Weekday) String() string {
+	code := strings.ToUpper(wd.Day.String()[:2])
+	if wd.N == 0 {
+		return code
+	}
+	return strconv.Itoa(wd.N) + code
+}
+
+// Next returns the first occurrence after start, where start is the first
+// occurrence of the series (DTSTART). ok is false when the series has ended.
+func (r *Rule) Next(start time.Time) (next time.Time, ok bool) {
+	occurrences := r.Occurrences(start, 1)
+	if len(occurrences) == 0 {
+		return time.Time{}, false
+	}
+	return occurrences[0], true
+}
+
+// Occurrences returns up to n occurrences following start, where start is
+// the first occurrence of the series (DTSTART) and counts towards COUNT.
+// Occurrences keep the clock time and location of start.
+func (r *Rule) Occurrences(start time.Time, n int) []time.Time {
+	var occurrences []time.Time
+	if n <= 0 || r.Count == 1 {
+		return occurrences
+	}
+
+	emitted := 1
+	for period := 0; period < maxPeriods; period++ {
+		for _, candidate := range r.candidates(start, period) {
+			if !candidate.After(start) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return occurrences
+			}
+
+			occurrences = append(occurrences, candidate)
+			emitted++
+			if len(occurrences) == n || (r.Count > 0 && emitted == r.Count) {
+				return occurrences
+			}
+		}
+	}
+	return occurrences
+}
+
+// candidates returns the matching days of the given period in order
+func (r *Rule) candidates(start time.Time, period int) []time.Time {
+	step := period * r.interval()
+	y, m, d := start.Date()
+
+	var first, end time.Time
+	switch r.Freq {
+	case Daily:
+		first = r.at(start, y, m, d+step)
+		end = r.at(start, y, m, d+step+1)
+	case Weekly:
+		monday := d - (int(start.Weekday())+6)%7
+		first = r.at(start, y, m, monday+7*step)
+		end = r.at(start, y, m, monday+7*step+7)
+	case Monthly:
+		first = r.at(start, y, m+time.Month(step), 1)
+		end = r.at(start, y, m+time.Month(step)+1, 1)
+	case Yearly:
+		first = r.at(start, y+step, time.January, 1)
+		end = r.at(start, y+step+1, time.January, 1)
+	}
+
+	var days []time.Time
+	for day := first; day.Before(end); day = r.at(start, day.Year(), day.Month(), day.Day()+1) {
+		if r.matches(start, day, first, end) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// matches reports whether a day of the period [first, end) is an occurrence
+func (r *Rule) matches(start, day, first, end time.Time) bool {
+	if len(r.ByMonthDay) > 0 && !slices.ContainsFunc(r.ByMonthDay, func(n int) bool {
+		return n == day.Day() || n == day.Day()-daysIn(day)-1
+	}) {
+		return false
+	}
+
+	if len(r.ByDay) > 0 {
+		// Numbered weekdays count within the month unless the rule is yearly
+		if r.Freq != Yearly {
+			first = r.at(start, day.Year(), day.Month(), 1)
+			end = r.at(start, day.Year(), day.Month()+1, 1)
+		}
+		nth := daysBetween(first, day)/7 + 1
+		nthLast := -((daysBetween(day, end)-1)/7 + 1)
+		return slices.ContainsFunc(r.ByDay, func(wd Weekday) bool {
+			return wd.Day == day.Weekday() && (wd.N == 0 || wd.N == nth || wd.N == nthLast)
+		})
+	}
+	if len(r.ByMonthDay) > 0 {
+		return true
+	}
+
+	// Without BY parts the rule repeats on the weekday or date of start
+	switch r.Freq {
+	case Weekly:
+		return day.Weekday() == start.Weekday()
+	case Monthly:
+		return day.Day() == start.Day()
+	case Yearly:
+		return day.Month() == start.Month() && day.Day() == start.Day()
+	}
+	return true
+}
+
+// at returns the given date at the clock time and location of start.
+// Out of range days and months are normalized by time.Date.
+func (r *Rule) at(start time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+}
+
+// interval returns the interval, treating an unset one as 1
+func (r *Rule) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+// daysIn returns the number of days in the month of t
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// daysBetween returns the number of calendar days from a to b
+func daysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	diff := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC))
+	return int(diff.Hours() / 24)
+}
+
+// parseByDay parses a BYDAY list such as "MO,WE" or "1MO,-1FR"
+func parseByDay(value string) ([]Weekday, error) {
+	var days []Weekday
+	for _, entry := range strings.Split(value, ",") {
+		if len(entry) < 2 {
+			return nil, invalid("malformed BYDAY %q", entry)
+		}
+		day, ok := weekdayCodes[entry[len(entry)-2:]]
+		if !ok {
+			return nil, invalid("malformed BYDAY %q", entry)
+		}
+
+		wd := Weekday{Day: day}
+		if prefix := entry[:len(entry)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, invalid("malformed BYDAY %q", entry)
+			}
+			wd.N = n
+		}
+		days = append(days, wd)
+	}
+	return days, nil
+}
+
+// parseByMonthDay parses a BYMONTHDAY list such as "1,15,-1"
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, entry := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(entry)
+		if err != nil || n == 0 || n < -31 || n > 31 {
+			return nil, invalid("malformed BYMONTHDAY %q", entry)
+		}
+		days = append(days, n)
+	}
+	return days, nil
+}
+
+// parseUntil parses an UNTIL value. A date without a time ends the series
+// at the end of that day in UTC.
+func parseUntil(value string) (*time.Time, error) {
+	for i, layout := range untilLayouts {
+		until, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		if i == 1 {
+			until = until.Add(24*time.Hour - time.Second)
+		}
+		return &until, nil
+	}
+	return nil, invalid("malformed UNTIL %q", value)
+}
+
+// positiveInt parses a positive integer part value
+func positiveInt(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, invalid("%s must be a positive integer", name)
+	}
+	return n, nil
+}
+
+// invalid wraps ErrInvalidRule with details
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRule, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,170 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "daily", input: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "prefix, case and spacing", input: " RRULE:freq=weekly; byday=mo,we ", want: "FREQ=WEEKLY;BYDAY=MO,WE"},
+		{name: "interval of one is dropped", input: "FREQ=DAILY;INTERVAL=1", want: "FREQ=DAILY"},
+		{name: "numbered weekdays", input: "FREQ=MONTHLY;BYDAY=1MO,-1FR;COUNT=3", want: "FREQ=MONTHLY;BYDAY=1MO,-1FR;COUNT=3"},
+		{name: "month days", input: "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=1,-1", want: "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=1,-1"},
+		{name: "until date ends the day", input: "FREQ=DAILY;UNTIL=20250131", want: "FREQ=DAILY;UNTIL=20250131T235959Z"},
+		{name: "until date-time", input: "FREQ=YEARLY;UNTIL=20250131T120000Z", want: "FREQ=YEARLY;UNTIL=20250131T120000Z"},
+		{name: "empty", input: "", wantErr: true},
+		{name: "missing FREQ", input: "INTERVAL=2", wantErr: true},
+		{name: "unsupported FREQ", input: "FREQ=HOURLY", wantErr: true},
+		{name: "unsupported part", input: "FREQ=DAILY;BYHOUR=9", wantErr: true},
+		{name: "repeated part", input: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+		{name: "malformed part", input: "FREQ", wantErr: true},
+		{name: "zero interval", input: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "COUNT with UNTIL", input: "FREQ=DAILY;COUNT=2;UNTIL=20250131", wantErr: true},
+		{name: "weekly month days", input: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{name: "numbered weekday in weekly rule", input: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{name: "unknown weekday", input: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "month day out of range", input: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "malformed until", input: "FREQ=DAILY;UNTIL=2025-01-31", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRule) {
+					t.Fatalf("Parse(%q) error = %v, want %v", tt.input, err, ErrInvalidRule)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := rule.String(); got != tt.want {
+				t.Errorf("Parse(%q).String() = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		n     int
+		want  []string
+	}{
+		{
+			name:  "daily",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: time.Date(2025, 1, 30, 9, 0, 0, 0, time.UTC),
+			n:     3,
+			want:  []string{"2025-02-01T09:00:00Z", "2025-02-03T09:00:00Z", "2025-02-05T09:00:00Z"},
+		},
+		{
+			name:  "weekly on several days",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,FR",
+			start: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC), // a Monday
+			n:     3,
+			want:  []string{"2025-01-10T09:00:00Z", "2025-01-13T09:00:00Z", "2025-01-17T09:00:00Z"},
+		},
+		{
+			name:  "monthly on the last day",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			n:     2,
+			want:  []string{"2025-02-28T09:00:00Z", "2025-03-31T09:00:00Z"},
+		},
+		{
+			name:  "monthly on the 31st skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			n:     2,
+			want:  []string{"2025-03-31T09:00:00Z", "2025-05-31T09:00:00Z"},
+		},
+		{
+			name:  "last Friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR",
+			start: time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			n:     2,
+			want:  []string{"2025-02-28T09:00:00Z", "2025-03-28T09:00:00Z"},
+		},
+		{
+			name:  "yearly on a leap day",
+			rule:  "FREQ=YEARLY",
+			start: time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+			n:     1,
+			want:  []string{"2028-02-29T09:00:00Z"},
+		},
+		{
+			name:  "count includes the start",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+			n:     10,
+			want:  []string{"2025-01-02T09:00:00Z", "2025-01-03T09:00:00Z"},
+		},
+		{
+			name:  "until is inclusive",
+			rule:  "FREQ=DAILY;UNTIL=20250102",
+			start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+			n:     10,
+			want:  []string{"2025-01-02T09:00:00Z"},
+		},
+		{
+			name:  "clock time kept across daylight saving",
+			rule:  "FREQ=DAILY",
+			start: time.Date(2025, 3, 29, 9, 0, 0, 0, berlin),
+			n:     2,
+			want:  []string{"2025-03-30T09:00:00+02:00", "2025-03-31T09:00:00+02:00"},
+		},
+		{
+			name:  "never matching rule ends",
+			rule:  "FREQ=MONTHLY;BYDAY=5MO;BYMONTHDAY=1",
+			start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+			n:     1,
+		},
+		{
+			name:  "single occurrence",
+			rule:  "FREQ=DAILY;COUNT=1",
+			start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+			n:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.rule, err)
+			}
+
+			got := rule.Occurrences(tt.start, tt.n)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Occurrences() = %v, want %v", got, tt.want)
+			}
+			for i, occurrence := range got {
+				if s := occurrence.Format(time.RFC3339); s != tt.want[i] {
+					t.Errorf("Occurrences()[%d] = %s, want %s", i, s, tt.want[i])
+				}
+			}
+
+			next, ok := rule.Next(tt.start)
+			if ok != (len(tt.want) > 0) || (ok && !next.Equal(got[0])) {
+				t.Errorf("Next() = %v, %v, want the first occurrence", next, ok)
+			}
+		})
+	}
+}